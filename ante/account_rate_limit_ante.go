@@ -0,0 +1,53 @@
+package ante
+
+import (
+	"github.com/armon/go-metrics"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	gaiaerrors "github.com/cosmos/gaia/v17/types/errors"
+	gaiafeeante "github.com/cosmos/gaia/v17/x/globalfee/ante"
+	txlimittypes "github.com/cosmos/gaia/v17/x/txlimit/types"
+)
+
+// metricAccountRateLimitRejectedTxs counts the txs rejected by the
+// AccountRateLimitDecorator.
+var metricAccountRateLimitRejectedTxs = []string{"ante", "account_rate_limit", "rejected_txs"}
+
+// TxLimitKeeper returns the limits of the txs and rate limits the txs paid by
+// an account, see the x/txlimit module.
+type TxLimitKeeper interface {
+	GetParams(ctx sdk.Context) txlimittypes.Params
+	ConsumeAccountUsage(ctx sdk.Context, addr sdk.AccAddress, gas uint64) error
+}
+
+// AccountRateLimitDecorator rejects the txs of a fee payer over its limit of
+// txs or gas per window of blocks, set by governance. It must run after the
+// signatures are verified, so that a tx cannot consume the usage of another
+// account.
+type AccountRateLimitDecorator struct {
+	txLimitKeeper TxLimitKeeper
+}
+
+func NewAccountRateLimitDecorator(txLimitKeeper TxLimitKeeper) AccountRateLimitDecorator {
+	return AccountRateLimitDecorator{
+		txLimitKeeper: txLimitKeeper,
+	}
+}
+
+func (d AccountRateLimitDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, errorsmod.Wrap(gaiaerrors.ErrTxDecode, "Tx must implement the sdk.FeeTx interface")
+	}
+
+	if err := d.txLimitKeeper.ConsumeAccountUsage(ctx, feeTx.FeePayer(), feeTx.GetGas()); err != nil {
+		telemetry.IncrCounterWithLabels(metricAccountRateLimitRejectedTxs, 1, []metrics.Label{gaiafeeante.ModeLabel(ctx)})
+		return ctx, err
+	}
+
+	return next(ctx, tx, simulate)
+}
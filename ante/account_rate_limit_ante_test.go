@@ -0,0 +1,49 @@
+package ante_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/cosmos/gaia/v17/ante"
+	"github.com/cosmos/gaia/v17/app/helpers"
+	gaiaerrors "github.com/cosmos/gaia/v17/types/errors"
+	txlimittypes "github.com/cosmos/gaia/v17/x/txlimit/types"
+)
+
+func TestAccountRateLimitDecorator(t *testing.T) {
+	gaiaApp := helpers.Setup(t)
+	ctx := gaiaApp.NewUncachedContext(true, tmproto.Header{Height: 10})
+	txConfig := gaiaApp.GetTxConfig()
+	decorator := ante.NewAccountRateLimitDecorator(gaiaApp.TxLimitKeeper)
+
+	payer := sdk.AccAddress("payer_______________")
+	relayer := sdk.AccAddress("relayer_____________")
+	gaiaApp.TxLimitKeeper.SetParams(ctx, txlimittypes.NewParams(10, 2, 0, []string{relayer.String()}, 0, 0, 0))
+
+	anteHandle := func(feePayer sdk.AccAddress) error {
+		txBuilder := txConfig.NewTxBuilder()
+		require.NoError(t, txBuilder.SetMsgs(&banktypes.MsgSend{FromAddress: feePayer.String()}))
+		txBuilder.SetGasLimit(100_000)
+
+		_, err := decorator.AnteHandle(
+			ctx,
+			txBuilder.GetTx(),
+			false,
+			func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) { return ctx, nil },
+		)
+		return err
+	}
+
+	require.NoError(t, anteHandle(payer))
+	require.NoError(t, anteHandle(payer))
+	require.ErrorIs(t, anteHandle(payer), gaiaerrors.ErrTxRateLimited)
+	for i := 0; i < 3; i++ {
+		require.NoError(t, anteHandle(relayer))
+	}
+}
@@ -25,9 +25,45 @@ type HandlerOptions struct {
 	GlobalFeeSubspace paramtypes.Subspace
 	StakingKeeper     *stakingkeeper.Keeper
 	TxFeeChecker      ante.TxFeeChecker
+
+	CircuitBreakerKeeper CircuitBreakerKeeper
+	TxLimitKeeper        TxLimitKeeper
+
+	// The node-local settings below only apply in CheckTx.
+
+	// LocalBypassMinFeeMsgTypes are the msg types of the txs that only pay
+	// the global fees.
+	LocalBypassMinFeeMsgTypes []string
+	// MinTxPriority is the minimum priority of the txs, 0 disables the check.
+	MinTxPriority int64
+	// MetaprotocolsMaxExtensionOptions and MetaprotocolsMaxExtensionSize limit
+	// the non-critical extension options of the txs, 0 is no limit.
+	MetaprotocolsMaxExtensionOptions uint64
+	MetaprotocolsMaxExtensionSize    uint64
+
+	// ExtraDecorators are the decorators added at each insertion point, in
+	// order.
+	ExtraDecorators map[InsertionPoint][]NamedDecorator
+	// ModifyDecorators, if set, can add, remove or reorder the decorators
+	// once the extra decorators are inserted. The required decorators must be
+	// kept in order.
+	ModifyDecorators func(decorators []NamedDecorator) []NamedDecorator
 }
 
 func NewAnteHandler(opts HandlerOptions) (sdk.AnteHandler, error) {
+	anteDecorators, err := NewAnteDecorators(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return ChainDecorators(anteDecorators), nil
+}
+
+// NewAnteDecorators returns the decorators of the AnteHandler in order: the
+// Gaia decorators, with the extra decorators at their insertion points, as
+// modified by ModifyDecorators. The decorators are validated, see
+// ValidateDecorators.
+func NewAnteDecorators(opts HandlerOptions) ([]NamedDecorator, error) {
 	if opts.AccountKeeper == nil {
 		return nil, errorsmod.Wrap(gaiaerrors.ErrLogic, "account keeper is required for AnteHandler")
 	}
@@ -49,28 +85,78 @@ func NewAnteHandler(opts HandlerOptions) (sdk.AnteHandler, error) {
 		return nil, errorsmod.Wrap(gaiaerrors.ErrNotFound, "staking param store is required for AnteHandler")
 	}
 
+	if opts.CircuitBreakerKeeper == nil {
+		return nil, errorsmod.Wrap(gaiaerrors.ErrLogic, "circuit breaker keeper is required for AnteHandler")
+	}
+
+	if opts.TxLimitKeeper == nil {
+		return nil, errorsmod.Wrap(gaiaerrors.ErrLogic, "tx limit keeper is required for AnteHandler")
+	}
+
+	for point := range opts.ExtraDecorators {
+		if !point.IsValid() {
+			return nil, errorsmod.Wrapf(gaiaerrors.ErrLogic, "unknown decorators insertion point %q", point)
+		}
+	}
+
 	sigGasConsumer := opts.SigGasConsumer
 	if sigGasConsumer == nil {
 		sigGasConsumer = ante.DefaultSigVerificationGasConsumer
 	}
 
-	anteDecorators := []sdk.AnteDecorator{
-		ante.NewSetUpContextDecorator(), // outermost AnteDecorator. SetUpContext must be called first
-		ante.NewExtensionOptionsDecorator(opts.ExtensionOptionChecker),
-		ante.NewValidateBasicDecorator(),
-		ante.NewTxTimeoutHeightDecorator(),
-		ante.NewValidateMemoDecorator(opts.AccountKeeper),
-		ante.NewConsumeGasForTxSizeDecorator(opts.AccountKeeper),
-		NewGovVoteDecorator(opts.Codec, opts.StakingKeeper),
-		gaiafeeante.NewFeeDecorator(opts.GlobalFeeSubspace, opts.StakingKeeper),
-		ante.NewDeductFeeDecorator(opts.AccountKeeper, opts.BankKeeper, opts.FeegrantKeeper, opts.TxFeeChecker),
-		ante.NewSetPubKeyDecorator(opts.AccountKeeper), // SetPubKeyDecorator must be called before all signature verification decorators
-		ante.NewValidateSigCountDecorator(opts.AccountKeeper),
-		ante.NewSigGasConsumeDecorator(opts.AccountKeeper, sigGasConsumer),
-		ante.NewSigVerificationDecorator(opts.AccountKeeper, opts.SignModeHandler),
-		ante.NewIncrementSequenceDecorator(opts.AccountKeeper),
-		ibcante.NewRedundantRelayDecorator(opts.IBCkeeper),
+	feeDecorator := gaiafeeante.NewFeeDecorator(opts.GlobalFeeSubspace, opts.StakingKeeper).
+		WithLocalBypassMinFeeMsgTypes(opts.LocalBypassMinFeeMsgTypes)
+
+	anteDecorators := []NamedDecorator{
+		{DecoratorSetUpContext, ante.NewSetUpContextDecorator()}, // outermost AnteDecorator. SetUpContext must be called first
+		{DecoratorExtensionOptions, ante.NewExtensionOptionsDecorator(opts.ExtensionOptionChecker)},
+		{DecoratorMetaprotocolsLimit, NewMetaprotocolsLimitDecorator(opts.MetaprotocolsMaxExtensionOptions, opts.MetaprotocolsMaxExtensionSize)},
+		{DecoratorValidateBasic, ante.NewValidateBasicDecorator()},
+		{DecoratorTxTimeoutHeight, ante.NewTxTimeoutHeightDecorator()},
+		{DecoratorValidateMemo, ante.NewValidateMemoDecorator(opts.AccountKeeper)},
+		{DecoratorConsumeTxSizeGas, ante.NewConsumeGasForTxSizeDecorator(opts.AccountKeeper)},
+		{DecoratorTxLimits, NewTxLimitsDecorator(opts.Codec, opts.TxLimitKeeper)},
+		{DecoratorCircuitBreaker, NewCircuitBreakerDecorator(opts.Codec, opts.CircuitBreakerKeeper)},
+		{DecoratorGovVote, NewGovVoteDecorator(opts.Codec, opts.StakingKeeper)},
+	}
+	anteDecorators = append(anteDecorators, opts.ExtraDecorators[PreFee]...)
+	anteDecorators = append(anteDecorators,
+		NamedDecorator{DecoratorGlobalFee, feeDecorator},
+		NamedDecorator{DecoratorDeductFee, ante.NewDeductFeeDecorator(opts.AccountKeeper, opts.BankKeeper, opts.FeegrantKeeper, opts.TxFeeChecker)},
+		// MinTxPriorityDecorator must be called after the DeductFeeDecorator, which sets the priority
+		NamedDecorator{DecoratorMinTxPriority, NewMinTxPriorityDecorator(opts.MinTxPriority, feeDecorator)},
+	)
+	anteDecorators = append(anteDecorators, opts.ExtraDecorators[PostFee]...)
+	anteDecorators = append(anteDecorators,
+		NamedDecorator{DecoratorSetPubKey, ante.NewSetPubKeyDecorator(opts.AccountKeeper)}, // SetPubKeyDecorator must be called before all signature verification decorators
+		NamedDecorator{DecoratorValidateSigCount, ante.NewValidateSigCountDecorator(opts.AccountKeeper)},
+		NamedDecorator{DecoratorSigGasConsume, ante.NewSigGasConsumeDecorator(opts.AccountKeeper, sigGasConsumer)},
+		NamedDecorator{DecoratorSigVerification, ante.NewSigVerificationDecorator(opts.AccountKeeper, opts.SignModeHandler)},
+		NamedDecorator{DecoratorIncrementSequence, ante.NewIncrementSequenceDecorator(opts.AccountKeeper)},
+		// AccountRateLimitDecorator must be called after the signatures are verified
+		NamedDecorator{DecoratorAccountRateLimit, NewAccountRateLimitDecorator(opts.TxLimitKeeper)},
+	)
+	anteDecorators = append(anteDecorators, opts.ExtraDecorators[PostSig]...)
+	anteDecorators = append(anteDecorators,
+		NamedDecorator{DecoratorRedundantRelay, ibcante.NewRedundantRelayDecorator(opts.IBCkeeper)},
+	)
+
+	if opts.ModifyDecorators != nil {
+		anteDecorators = opts.ModifyDecorators(anteDecorators)
+	}
+	if err := ValidateDecorators(anteDecorators); err != nil {
+		return nil, err
+	}
+
+	return anteDecorators, nil
+}
+
+// ChainDecorators returns the AnteHandler running the decorators in order.
+func ChainDecorators(decorators []NamedDecorator) sdk.AnteHandler {
+	anteDecorators := make([]sdk.AnteDecorator, len(decorators))
+	for i, d := range decorators {
+		anteDecorators[i] = d.Decorator
 	}
 
-	return sdk.ChainAnteDecorators(anteDecorators...), nil
+	return sdk.ChainAnteDecorators(anteDecorators...)
 }
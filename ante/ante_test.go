@@ -0,0 +1,219 @@
+package ante_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
+
+	"github.com/cosmos/gaia/v17/ante"
+	gaiaapp "github.com/cosmos/gaia/v17/app"
+	"github.com/cosmos/gaia/v17/app/helpers"
+	gaiaerrors "github.com/cosmos/gaia/v17/types/errors"
+	"github.com/cosmos/gaia/v17/x/globalfee"
+)
+
+var defaultDecorators = []string{
+	ante.DecoratorSetUpContext,
+	ante.DecoratorExtensionOptions,
+	ante.DecoratorMetaprotocolsLimit,
+	ante.DecoratorValidateBasic,
+	ante.DecoratorTxTimeoutHeight,
+	ante.DecoratorValidateMemo,
+	ante.DecoratorConsumeTxSizeGas,
+	ante.DecoratorTxLimits,
+	ante.DecoratorCircuitBreaker,
+	ante.DecoratorGovVote,
+	ante.DecoratorGlobalFee,
+	ante.DecoratorDeductFee,
+	ante.DecoratorMinTxPriority,
+	ante.DecoratorSetPubKey,
+	ante.DecoratorValidateSigCount,
+	ante.DecoratorSigGasConsume,
+	ante.DecoratorSigVerification,
+	ante.DecoratorIncrementSequence,
+	ante.DecoratorAccountRateLimit,
+	ante.DecoratorRedundantRelay,
+}
+
+// recordDecorator appends its name to calls when it runs.
+type recordDecorator struct {
+	name  string
+	calls *[]string
+}
+
+func (d recordDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	*d.calls = append(*d.calls, d.name)
+	return next(ctx, tx, simulate)
+}
+
+func newHandlerOptions(gaiaApp *gaiaapp.GaiaApp) ante.HandlerOptions {
+	return ante.HandlerOptions{
+		HandlerOptions: authante.HandlerOptions{
+			AccountKeeper:   gaiaApp.AccountKeeper,
+			BankKeeper:      gaiaApp.BankKeeper,
+			FeegrantKeeper:  gaiaApp.FeeGrantKeeper,
+			SignModeHandler: gaiaApp.GetTxConfig().SignModeHandler(),
+		},
+		Codec:             gaiaApp.AppCodec(),
+		IBCkeeper:         gaiaApp.IBCKeeper,
+		GlobalFeeSubspace: gaiaApp.GetSubspace(globalfee.ModuleName),
+		StakingKeeper:     gaiaApp.StakingKeeper,
+
+		CircuitBreakerKeeper: gaiaApp.CircuitBreakerKeeper,
+		TxLimitKeeper:        gaiaApp.TxLimitKeeper,
+	}
+}
+
+func decoratorNames(decorators []ante.NamedDecorator) []string {
+	names := make([]string, len(decorators))
+	for i, d := range decorators {
+		names[i] = d.Name
+	}
+	return names
+}
+
+func TestNewAnteDecorators(t *testing.T) {
+	gaiaApp := helpers.Setup(t)
+
+	decorators, err := ante.NewAnteDecorators(newHandlerOptions(gaiaApp))
+	require.NoError(t, err)
+	require.Equal(t, defaultDecorators, decoratorNames(decorators))
+	require.Equal(t, defaultDecorators, decoratorNames(gaiaApp.AnteDecorators()))
+
+	// extra decorators at the insertion points
+	var calls []string
+	extra := func(name string) ante.NamedDecorator {
+		return ante.NamedDecorator{Name: name, Decorator: recordDecorator{name: name, calls: &calls}}
+	}
+	opts := newHandlerOptions(gaiaApp)
+	opts.ExtraDecorators = map[ante.InsertionPoint][]ante.NamedDecorator{
+		ante.PreFee:  {extra("pre-fee-1"), extra("pre-fee-2")},
+		ante.PostFee: {extra("post-fee")},
+		ante.PostSig: {extra("post-sig")},
+	}
+	decorators, err = ante.NewAnteDecorators(opts)
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		ante.DecoratorSetUpContext,
+		ante.DecoratorExtensionOptions,
+		ante.DecoratorMetaprotocolsLimit,
+		ante.DecoratorValidateBasic,
+		ante.DecoratorTxTimeoutHeight,
+		ante.DecoratorValidateMemo,
+		ante.DecoratorConsumeTxSizeGas,
+		ante.DecoratorTxLimits,
+		ante.DecoratorCircuitBreaker,
+		ante.DecoratorGovVote,
+		"pre-fee-1",
+		"pre-fee-2",
+		ante.DecoratorGlobalFee,
+		ante.DecoratorDeductFee,
+		ante.DecoratorMinTxPriority,
+		"post-fee",
+		ante.DecoratorSetPubKey,
+		ante.DecoratorValidateSigCount,
+		ante.DecoratorSigGasConsume,
+		ante.DecoratorSigVerification,
+		ante.DecoratorIncrementSequence,
+		ante.DecoratorAccountRateLimit,
+		"post-sig",
+		ante.DecoratorRedundantRelay,
+	}, decoratorNames(decorators))
+
+	// the optional decorators can be removed and the extra decorators reordered
+	opts.ModifyDecorators = func(decorators []ante.NamedDecorator) []ante.NamedDecorator {
+		var modified []ante.NamedDecorator
+		for _, d := range decorators {
+			switch d.Name {
+			case ante.DecoratorGovVote, ante.DecoratorRedundantRelay, "pre-fee-1":
+			case "post-sig":
+				modified = append([]ante.NamedDecorator{modified[0], d}, modified[1:]...)
+			default:
+				modified = append(modified, d)
+			}
+		}
+		return modified
+	}
+	decorators, err = ante.NewAnteDecorators(opts)
+	require.NoError(t, err)
+	require.Equal(t, []string{ante.DecoratorSetUpContext, "post-sig"}, decoratorNames(decorators)[:2])
+	require.NotContains(t, decoratorNames(decorators), ante.DecoratorGovVote)
+
+	// the required decorators cannot be removed
+	opts.ModifyDecorators = func(decorators []ante.NamedDecorator) []ante.NamedDecorator {
+		return decorators[:len(decorators)-4]
+	}
+	_, err = ante.NewAnteDecorators(opts)
+	require.ErrorIs(t, err, gaiaerrors.ErrLogic)
+	require.ErrorContains(t, err, "required decorator increment-sequence is missing")
+
+	opts.ModifyDecorators = nil
+	opts.ExtraDecorators = map[ante.InsertionPoint][]ante.NamedDecorator{"pre-sig": {extra("pre-sig")}}
+	_, err = ante.NewAnteDecorators(opts)
+	require.ErrorContains(t, err, `unknown decorators insertion point "pre-sig"`)
+}
+
+func TestChainDecorators(t *testing.T) {
+	var calls []string
+	decorators := make([]ante.NamedDecorator, 3)
+	for i, name := range []string{"first", "second", "third"} {
+		decorators[i] = ante.NamedDecorator{Name: name, Decorator: recordDecorator{name: name, calls: &calls}}
+	}
+
+	_, err := ante.ChainDecorators(decorators)(sdk.Context{}, nil, false)
+	require.NoError(t, err)
+	require.Equal(t, []string{"first", "second", "third"}, calls)
+}
+
+func TestValidateDecorators(t *testing.T) {
+	named := func(names ...string) []ante.NamedDecorator {
+		decorators := make([]ante.NamedDecorator, len(names))
+		for i, name := range names {
+			decorators[i] = ante.NamedDecorator{Name: name, Decorator: recordDecorator{name: name}}
+		}
+		return decorators
+	}
+	swap := func(names []string, i, j string) []string {
+		swapped := append([]string{}, names...)
+		var pi, pj int
+		for k, name := range swapped {
+			switch name {
+			case i:
+				pi = k
+			case j:
+				pj = k
+			}
+		}
+		swapped[pi], swapped[pj] = swapped[pj], swapped[pi]
+		return swapped
+	}
+
+	require.NoError(t, ante.ValidateDecorators(named(defaultDecorators...)))
+	require.NoError(t, ante.ValidateDecorators(named(ante.RequiredDecorators...)))
+
+	for _, tc := range []struct {
+		name       string
+		decorators []ante.NamedDecorator
+		err        string
+	}{
+		{"empty", nil, "setup-context must be the first one"},
+		{"setup context not first", named(swap(defaultDecorators, ante.DecoratorSetUpContext, ante.DecoratorExtensionOptions)...), "setup-context must be the first one"},
+		{"missing required decorator", named(ante.RequiredDecorators[:len(ante.RequiredDecorators)-1]...), "required decorator increment-sequence is missing"},
+		{"deduct fee before globalfee", named(swap(defaultDecorators, ante.DecoratorGlobalFee, ante.DecoratorDeductFee)...), "deduct-fee must run after globalfee"},
+		{"sig verification before set pubkey", named(swap(defaultDecorators, ante.DecoratorSetPubKey, ante.DecoratorSigVerification)...), "validate-sig-count must run after set-pubkey"},
+		{"min tx priority before deduct fee", named(swap(defaultDecorators, ante.DecoratorMinTxPriority, ante.DecoratorDeductFee)...), "min-tx-priority must run after deduct-fee"},
+		{"account rate limit before sig verification", named(swap(defaultDecorators, ante.DecoratorAccountRateLimit, ante.DecoratorGovVote)...), "account-rate-limit must run after sig-verification"},
+		{"duplicate", named(append(defaultDecorators, ante.DecoratorGovVote)...), "duplicate decorator gov-vote"},
+		{"no name", named(append(defaultDecorators, "")...), "has no name"},
+		{"nil decorator", append(named(defaultDecorators...), ante.NamedDecorator{Name: "nil"}), "decorator nil is nil"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ante.ValidateDecorators(tc.decorators)
+			require.ErrorIs(t, err, gaiaerrors.ErrLogic)
+			require.ErrorContains(t, err, tc.err)
+		})
+	}
+}
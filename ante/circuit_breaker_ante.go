@@ -0,0 +1,83 @@
+package ante
+
+import (
+	"github.com/armon/go-metrics"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+
+	gaiaerrors "github.com/cosmos/gaia/v17/types/errors"
+	gaiafeeante "github.com/cosmos/gaia/v17/x/globalfee/ante"
+)
+
+// metricCircuitBreakerRejectedTxs counts the txs rejected by the
+// CircuitBreakerDecorator, by msg type.
+var metricCircuitBreakerRejectedTxs = []string{"ante", "circuit_breaker", "rejected_txs"}
+
+// CircuitBreakerKeeper returns whether a msg type is disabled by the circuit
+// breaker.
+type CircuitBreakerKeeper interface {
+	IsTripped(ctx sdk.Context, msgTypeURL string) bool
+}
+
+// CircuitBreakerDecorator rejects the txs with a msg disabled by the circuit
+// breaker, including the msgs executed by an authz MsgExec. The msgs executed
+// by governance proposals or by interchain accounts do not go through the
+// AnteHandler and are not checked.
+type CircuitBreakerDecorator struct {
+	circuitBreakerKeeper CircuitBreakerKeeper
+	cdc                  codec.BinaryCodec
+}
+
+func NewCircuitBreakerDecorator(cdc codec.BinaryCodec, circuitBreakerKeeper CircuitBreakerKeeper) CircuitBreakerDecorator {
+	return CircuitBreakerDecorator{
+		circuitBreakerKeeper: circuitBreakerKeeper,
+		cdc:                  cdc,
+	}
+}
+
+func (c CircuitBreakerDecorator) AnteHandle(
+	ctx sdk.Context, tx sdk.Tx,
+	simulate bool, next sdk.AnteHandler,
+) (newCtx sdk.Context, err error) {
+	if err := c.ValidateMsgs(ctx, tx.GetMsgs()); err != nil {
+		return ctx, err
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// ValidateMsgs checks that none of the msgs, or of the msgs they execute
+// through authz, is disabled by the circuit breaker.
+func (c CircuitBreakerDecorator) ValidateMsgs(ctx sdk.Context, msgs []sdk.Msg) error {
+	for _, m := range msgs {
+		msgTypeURL := sdk.MsgTypeURL(m)
+		if c.circuitBreakerKeeper.IsTripped(ctx, msgTypeURL) {
+			telemetry.IncrCounterWithLabels(metricCircuitBreakerRejectedTxs, 1, []metrics.Label{
+				gaiafeeante.ModeLabel(ctx),
+				telemetry.NewLabel("msg_type", msgTypeURL),
+			})
+			return errorsmod.Wrapf(gaiaerrors.ErrCircuitBreakerTripped, "%s is disabled", msgTypeURL)
+		}
+
+		execMsg, ok := m.(*authz.MsgExec)
+		if !ok {
+			continue
+		}
+		innerMsgs := make([]sdk.Msg, len(execMsg.Msgs))
+		for i, v := range execMsg.Msgs {
+			if err := c.cdc.UnpackAny(v, &innerMsgs[i]); err != nil {
+				return errorsmod.Wrap(gaiaerrors.ErrUnauthorized, "cannot unmarshal authz exec msgs")
+			}
+		}
+		if err := c.ValidateMsgs(ctx, innerMsgs); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
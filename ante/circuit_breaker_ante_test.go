@@ -0,0 +1,74 @@
+package ante_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/cosmos/gaia/v17/ante"
+	"github.com/cosmos/gaia/v17/app/helpers"
+	gaiaerrors "github.com/cosmos/gaia/v17/types/errors"
+)
+
+func TestCircuitBreakerDecorator(t *testing.T) {
+	gaiaApp := helpers.Setup(t)
+	ctx := gaiaApp.NewUncachedContext(true, tmproto.Header{Height: 10})
+	txConfig := gaiaApp.GetTxConfig()
+	decorator := ante.NewCircuitBreakerDecorator(gaiaApp.AppCodec(), gaiaApp.CircuitBreakerKeeper)
+
+	govAuthority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	grantee := sdk.AccAddress("grantee_____________")
+	send := &banktypes.MsgSend{}
+	delegate := &stakingtypes.MsgDelegate{}
+	require.NoError(t, gaiaApp.CircuitBreakerKeeper.Trip(ctx, govAuthority, []string{sdk.MsgTypeURL(send)}, 0, ""))
+
+	execSend := authz.NewMsgExec(grantee, []sdk.Msg{send})
+	execDelegate := authz.NewMsgExec(grantee, []sdk.Msg{delegate})
+	nestedExecSend := authz.NewMsgExec(grantee, []sdk.Msg{delegate, &execSend})
+
+	tests := []struct {
+		name       string
+		msgs       []sdk.Msg
+		expectPass bool
+	}{
+		{"msg not tripped", []sdk.Msg{delegate}, true},
+		{"tripped msg", []sdk.Msg{delegate, send}, false},
+		{"authz exec of msg not tripped", []sdk.Msg{&execDelegate}, true},
+		{"authz exec of tripped msg", []sdk.Msg{&execSend}, false},
+		{"nested authz exec of tripped msg", []sdk.Msg{&nestedExecSend}, false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			txBuilder := txConfig.NewTxBuilder()
+			require.NoError(t, txBuilder.SetMsgs(tc.msgs...))
+
+			for _, simulate := range []bool{false, true} {
+				_, err := decorator.AnteHandle(
+					ctx,
+					txBuilder.GetTx(),
+					simulate,
+					func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) { return ctx, nil },
+				)
+				if tc.expectPass {
+					require.NoError(t, err)
+				} else {
+					require.ErrorIs(t, err, gaiaerrors.ErrCircuitBreakerTripped)
+				}
+			}
+		})
+	}
+
+	// the msg type is reset
+	require.NoError(t, gaiaApp.CircuitBreakerKeeper.Reset(ctx, govAuthority, []string{sdk.MsgTypeURL(send)}))
+	require.NoError(t, decorator.ValidateMsgs(ctx, []sdk.Msg{&nestedExecSend}))
+}
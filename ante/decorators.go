@@ -0,0 +1,123 @@
+package ante
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	gaiaerrors "github.com/cosmos/gaia/v17/types/errors"
+)
+
+// The names of the decorators of the Gaia AnteHandler.
+const (
+	DecoratorSetUpContext       = "setup-context"
+	DecoratorExtensionOptions   = "extension-options"
+	DecoratorMetaprotocolsLimit = "metaprotocols-limit"
+	DecoratorValidateBasic      = "validate-basic"
+	DecoratorTxTimeoutHeight    = "tx-timeout-height"
+	DecoratorValidateMemo       = "validate-memo"
+	DecoratorConsumeTxSizeGas   = "consume-tx-size-gas"
+	DecoratorTxLimits           = "tx-limits"
+	DecoratorCircuitBreaker     = "circuit-breaker"
+	DecoratorGovVote            = "gov-vote"
+	DecoratorGlobalFee          = "globalfee"
+	DecoratorDeductFee          = "deduct-fee"
+	DecoratorMinTxPriority      = "min-tx-priority"
+	DecoratorSetPubKey          = "set-pubkey"
+	DecoratorValidateSigCount   = "validate-sig-count"
+	DecoratorSigGasConsume      = "sig-gas-consume"
+	DecoratorSigVerification    = "sig-verification"
+	DecoratorIncrementSequence  = "increment-sequence"
+	DecoratorAccountRateLimit   = "account-rate-limit"
+	DecoratorRedundantRelay     = "redundant-relay"
+)
+
+// RequiredDecorators are the decorators that the AnteHandler must run, in
+// this relative order. The SetUpContext decorator must be the first one.
+var RequiredDecorators = []string{
+	DecoratorSetUpContext,
+	DecoratorValidateBasic,
+	DecoratorConsumeTxSizeGas,
+	DecoratorGlobalFee,
+	DecoratorDeductFee,
+	DecoratorSetPubKey,
+	DecoratorValidateSigCount,
+	DecoratorSigGasConsume,
+	DecoratorSigVerification,
+	DecoratorIncrementSequence,
+}
+
+// NamedDecorator is a decorator of the AnteHandler with a unique name.
+type NamedDecorator struct {
+	Name      string
+	Decorator sdk.AnteDecorator
+}
+
+// InsertionPoint is a position of the AnteHandler where extra decorators can
+// be inserted.
+type InsertionPoint string
+
+const (
+	// PreFee is before the globalfee decorator, once the tx is validated.
+	PreFee InsertionPoint = "pre-fee"
+	// PostFee is after the fees are deducted and the tx priority is checked,
+	// before the public keys are set.
+	PostFee InsertionPoint = "post-fee"
+	// PostSig is after the signatures are verified and the sequences
+	// incremented.
+	PostSig InsertionPoint = "post-sig"
+)
+
+// IsValid returns true if the insertion point is known.
+func (p InsertionPoint) IsValid() bool {
+	switch p {
+	case PreFee, PostFee, PostSig:
+		return true
+	default:
+		return false
+	}
+}
+
+// ValidateDecorators checks that the decorators have unique names and that
+// the required decorators are present in order, see RequiredDecorators. The
+// MinTxPriority decorator, if present, must run after the DeductFee
+// decorator, which sets the tx priority, and the AccountRateLimit decorator
+// after the SigVerification decorator.
+func ValidateDecorators(decorators []NamedDecorator) error {
+	positions := make(map[string]int, len(decorators))
+	for i, d := range decorators {
+		if d.Name == "" {
+			return errorsmod.Wrapf(gaiaerrors.ErrLogic, "decorator %d has no name", i)
+		}
+		if d.Decorator == nil {
+			return errorsmod.Wrapf(gaiaerrors.ErrLogic, "decorator %s is nil", d.Name)
+		}
+		if _, found := positions[d.Name]; found {
+			return errorsmod.Wrapf(gaiaerrors.ErrLogic, "duplicate decorator %s", d.Name)
+		}
+		positions[d.Name] = i
+	}
+
+	if len(decorators) == 0 || decorators[0].Name != DecoratorSetUpContext {
+		return errorsmod.Wrapf(gaiaerrors.ErrLogic, "decorator %s must be the first one", DecoratorSetUpContext)
+	}
+	previous := -1
+	for i, name := range RequiredDecorators {
+		pos, found := positions[name]
+		if !found {
+			return errorsmod.Wrapf(gaiaerrors.ErrLogic, "required decorator %s is missing", name)
+		}
+		if pos < previous {
+			return errorsmod.Wrapf(gaiaerrors.ErrLogic, "decorator %s must run after %s", name, RequiredDecorators[i-1])
+		}
+		previous = pos
+	}
+	if pos, found := positions[DecoratorMinTxPriority]; found && pos < positions[DecoratorDeductFee] {
+		return errorsmod.Wrapf(gaiaerrors.ErrLogic, "decorator %s must run after %s", DecoratorMinTxPriority, DecoratorDeductFee)
+	}
+	if pos, found := positions[DecoratorAccountRateLimit]; found && pos < positions[DecoratorSigVerification] {
+		return errorsmod.Wrapf(gaiaerrors.ErrLogic, "decorator %s must run after %s", DecoratorAccountRateLimit, DecoratorSigVerification)
+	}
+
+	return nil
+}
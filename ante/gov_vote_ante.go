@@ -1,9 +1,14 @@
 package ante
 
 import (
+	"errors"
+
+	"github.com/armon/go-metrics"
+
 	errorsmod "cosmossdk.io/errors"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/authz"
 	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
@@ -12,8 +17,13 @@ import (
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	gaiaerrors "github.com/cosmos/gaia/v17/types/errors"
+	gaiafeeante "github.com/cosmos/gaia/v17/x/globalfee/ante"
 )
 
+// metricGovVoteRejectedTxs counts the vote txs rejected by the
+// GovVoteDecorator, by reason.
+var metricGovVoteRejectedTxs = []string{"ante", "gov_vote", "rejected_txs"}
+
 var (
 	minStakedTokens       = sdk.NewDec(1000000) // 1_000_000 uatom (or 1 atom)
 	maxDelegationsChecked = 100                 // number of delegation to check for the minStakedTokens
@@ -48,6 +58,12 @@ func (g GovVoteDecorator) AnteHandle(
 
 	msgs := tx.GetMsgs()
 	if err = g.ValidateVoteMsgs(ctx, msgs); err != nil {
+		if errors.Is(err, gaiaerrors.ErrInsufficientStake) {
+			telemetry.IncrCounterWithLabels(metricGovVoteRejectedTxs, 1, []metrics.Label{
+				gaiafeeante.ModeLabel(ctx),
+				telemetry.NewLabel("reason", "insufficient_stake"),
+			})
+		}
 		return ctx, err
 	}
 
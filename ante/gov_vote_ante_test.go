@@ -2,7 +2,9 @@ package ante_test
 
 import (
 	"testing"
+	"time"
 
+	"github.com/armon/go-metrics"
 	"github.com/stretchr/testify/require"
 
 	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
@@ -17,6 +19,7 @@ import (
 
 	"github.com/cosmos/gaia/v17/ante"
 	"github.com/cosmos/gaia/v17/app/helpers"
+	gaiaerrors "github.com/cosmos/gaia/v17/types/errors"
 )
 
 // Test that the GovVoteDecorator rejects v1beta1 vote messages from accounts with less than 1 atom staked
@@ -247,3 +250,35 @@ func TestVoteSpamDecoratorGovV1(t *testing.T) {
 		}
 	}
 }
+
+// Test that the GovVoteDecorator counts the votes rejected for insufficient stake
+func TestVoteSpamDecoratorMetrics(t *testing.T) {
+	sink := metrics.NewInmemSink(time.Hour, time.Hour)
+	cfg := metrics.DefaultConfig("gaia")
+	cfg.EnableHostname = false
+	cfg.EnableRuntimeMetrics = false
+	_, err := metrics.NewGlobal(cfg, sink)
+	require.NoError(t, err)
+	defer func() {
+		_, _ = metrics.NewGlobal(cfg, &metrics.BlackholeSink{})
+	}()
+
+	gaiaApp := helpers.Setup(t)
+	ctx := gaiaApp.NewUncachedContext(true, tmproto.Header{})
+	decorator := ante.NewGovVoteDecorator(gaiaApp.AppCodec(), gaiaApp.StakingKeeper)
+
+	// the voter has no stake
+	txBuilder := gaiaApp.GetTxConfig().NewTxBuilder()
+	voter := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	require.NoError(t, txBuilder.SetMsgs(govv1.NewMsgVote(voter, 0, govv1.OptionYes, "")))
+	next := func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) { return ctx, nil }
+
+	_, err = decorator.AnteHandle(ctx, txBuilder.GetTx(), false, next)
+	require.ErrorIs(t, err, gaiaerrors.ErrInsufficientStake)
+	_, err = decorator.AnteHandle(ctx.WithIsCheckTx(false), txBuilder.GetTx(), false, next)
+	require.ErrorIs(t, err, gaiaerrors.ErrInsufficientStake)
+
+	counters := sink.Data()[0].Counters
+	require.Equal(t, 1, counters["gaia.ante.gov_vote.rejected_txs;mode=check;reason=insufficient_stake"].Count)
+	require.Equal(t, 1, counters["gaia.ante.gov_vote.rejected_txs;mode=deliver;reason=insufficient_stake"].Count)
+}
@@ -0,0 +1,51 @@
+package ante
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+
+	gaiaerrors "github.com/cosmos/gaia/v17/types/errors"
+)
+
+// MetaprotocolsLimitDecorator limits in CheckTx the number and the size of the
+// non-critical extension options of the txs, which carry the metaprotocols
+// data. A zero limit is no limit.
+type MetaprotocolsLimitDecorator struct {
+	maxOptions uint64
+	maxSize    uint64
+}
+
+func NewMetaprotocolsLimitDecorator(maxOptions, maxSize uint64) MetaprotocolsLimitDecorator {
+	return MetaprotocolsLimitDecorator{
+		maxOptions: maxOptions,
+		maxSize:    maxSize,
+	}
+}
+
+func (d MetaprotocolsLimitDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if simulate || !ctx.IsCheckTx() {
+		return next(ctx, tx, simulate)
+	}
+	extTx, ok := tx.(ante.HasExtensionOptionsTx)
+	if !ok {
+		return next(ctx, tx, simulate)
+	}
+
+	options := extTx.GetNonCriticalExtensionOptions()
+	if d.maxOptions > 0 && uint64(len(options)) > d.maxOptions {
+		return ctx, errorsmod.Wrapf(gaiaerrors.ErrExtensionOptionsLimit, "got %d extension options, the node accepts up to %d", len(options), d.maxOptions)
+	}
+	if d.maxSize > 0 {
+		var size uint64
+		for _, option := range options {
+			size += uint64(option.Size())
+		}
+		if size > d.maxSize {
+			return ctx, errorsmod.Wrapf(gaiaerrors.ErrExtensionOptionsLimit, "got %d bytes of extension options, the node accepts up to %d", size, d.maxSize)
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
@@ -0,0 +1,99 @@
+package ante_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/cosmos/gaia/v17/ante"
+	"github.com/cosmos/gaia/v17/app/helpers"
+	gaiaerrors "github.com/cosmos/gaia/v17/types/errors"
+	metaprotocolstypes "github.com/cosmos/gaia/v17/x/metaprotocols/types"
+)
+
+func TestMetaprotocolsLimitDecorator(t *testing.T) {
+	gaiaApp := helpers.Setup(t)
+	ctx := gaiaApp.NewUncachedContext(true, tmproto.Header{})
+
+	option, err := codectypes.NewAnyWithValue(&metaprotocolstypes.ExtensionData{
+		ProtocolId:      "some-protocol",
+		ProtocolVersion: "1",
+		Data:            make([]byte, 100),
+	})
+	require.NoError(t, err)
+	optionSize := uint64(option.Size())
+
+	tests := []struct {
+		name       string
+		options    int
+		maxOptions uint64
+		maxSize    uint64
+		checkTx    bool
+		expectPass bool
+	}{
+		{
+			name:       "no limit",
+			options:    3,
+			checkTx:    true,
+			expectPass: true,
+		},
+		{
+			name:       "within the limits",
+			options:    2,
+			maxOptions: 2,
+			maxSize:    2 * optionSize,
+			checkTx:    true,
+			expectPass: true,
+		},
+		{
+			name:       "too many options",
+			options:    3,
+			maxOptions: 2,
+			checkTx:    true,
+		},
+		{
+			name:    "options too large",
+			options: 2,
+			maxSize: 2*optionSize - 1,
+			checkTx: true,
+		},
+		{
+			name:       "too many options in DeliverTx",
+			options:    3,
+			maxOptions: 2,
+			expectPass: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			txBuilder := gaiaApp.GetTxConfig().NewTxBuilder()
+			require.NoError(t, txBuilder.SetMsgs(&banktypes.MsgSend{}))
+			options := make([]*codectypes.Any, tc.options)
+			for i := range options {
+				options[i] = option
+			}
+			txBuilder.(authtx.ExtensionOptionsTxBuilder).SetNonCriticalExtensionOptions(options...)
+
+			decorator := ante.NewMetaprotocolsLimitDecorator(tc.maxOptions, tc.maxSize)
+			_, err := decorator.AnteHandle(
+				ctx.WithIsCheckTx(tc.checkTx),
+				txBuilder.GetTx(),
+				false,
+				func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) { return ctx, nil },
+			)
+			if tc.expectPass {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, gaiaerrors.ErrExtensionOptionsLimit)
+			}
+		})
+	}
+}
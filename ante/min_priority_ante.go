@@ -0,0 +1,72 @@
+package ante
+
+import (
+	"math"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	gaiaerrors "github.com/cosmos/gaia/v17/types/errors"
+	gaiafeeante "github.com/cosmos/gaia/v17/x/globalfee/ante"
+)
+
+// PriorityScale is the tx priority of a fee of one unit per gas, so that gas
+// prices below one, e.g. 0.005uatom, give distinct priorities.
+const PriorityScale = 1_000_000
+
+// GetTxPriority returns the priority of a tx paying the fee for the gas limit,
+// i.e. its fee per gas in the bond denom times PriorityScale. The other denoms
+// are not comparable to the bond denom and do not give any priority, otherwise
+// a large amount of a low-value denom would outbid the txs paying in the bond
+// denom.
+func GetTxPriority(fee sdk.Coins, gas uint64, bondDenom string) int64 {
+	amount := fee.AmountOf(bondDenom)
+	if amount.IsZero() || gas == 0 {
+		return 0
+	}
+
+	priority := amount.MulRaw(PriorityScale).Quo(sdk.NewIntFromUint64(gas))
+	if !priority.IsInt64() {
+		return math.MaxInt64
+	}
+
+	return priority.Int64()
+}
+
+// MinTxPriorityDecorator rejects in CheckTx the txs with a priority lower than
+// the minimum priority of the node. The priority is set by the
+// DeductFeeDecorator, which must run before. The txs allowed to bypass the
+// minimum fees, globally or locally, are exempted.
+type MinTxPriorityDecorator struct {
+	minPriority  int64
+	feeDecorator gaiafeeante.FeeDecorator
+}
+
+func NewMinTxPriorityDecorator(minPriority int64, feeDecorator gaiafeeante.FeeDecorator) MinTxPriorityDecorator {
+	return MinTxPriorityDecorator{
+		minPriority:  minPriority,
+		feeDecorator: feeDecorator,
+	}
+}
+
+func (d MinTxPriorityDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if simulate || !ctx.IsCheckTx() || d.minPriority == 0 || ctx.Priority() >= d.minPriority {
+		return next(ctx, tx, simulate)
+	}
+
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, errorsmod.Wrap(gaiaerrors.ErrTxDecode, "Tx must implement the sdk.FeeTx interface")
+	}
+	msgs := feeTx.GetMsgs()
+	if d.feeDecorator.ContainsOnlyBypassMinFeeMsgs(ctx, msgs) && feeTx.GetGas() <= d.feeDecorator.GetMaxTotalBypassMinFeeMsgGasUsage(ctx) {
+		return next(ctx, tx, simulate)
+	}
+	if localTypes := d.feeDecorator.LocalBypassMinFeeMsgTypes; len(localTypes) > 0 && gaiafeeante.ContainsOnlyMsgTypes(msgs, localTypes) {
+		return next(ctx, tx, simulate)
+	}
+
+	gaiafeeante.RecordFeeRejection(ctx, gaiafeeante.FeeRejectionMinPriority)
+	return ctx, errorsmod.Wrapf(gaiaerrors.ErrInsufficientFee, "tx priority %d is lower than the minimum priority %d of the node", ctx.Priority(), d.minPriority)
+}
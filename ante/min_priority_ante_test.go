@@ -0,0 +1,171 @@
+package ante_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	ibcclienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/cosmos/gaia/v17/ante"
+	"github.com/cosmos/gaia/v17/app/helpers"
+	gaiaerrors "github.com/cosmos/gaia/v17/types/errors"
+	"github.com/cosmos/gaia/v17/x/globalfee"
+	gaiafeeante "github.com/cosmos/gaia/v17/x/globalfee/ante"
+	globalfeetypes "github.com/cosmos/gaia/v17/x/globalfee/types"
+)
+
+func TestGetTxPriority(t *testing.T) {
+	require.Equal(t, int64(0), ante.GetTxPriority(sdk.Coins{}, 100_000, "uatom"))
+	require.Equal(t, int64(0), ante.GetTxPriority(sdk.NewCoins(sdk.NewInt64Coin("uatom", 500)), 0, "uatom"))
+	// 0.005uatom per gas
+	require.Equal(t, int64(5000), ante.GetTxPriority(sdk.NewCoins(sdk.NewInt64Coin("uatom", 500)), 100_000, "uatom"))
+	require.Equal(t, int64(math.MaxInt64), ante.GetTxPriority(sdk.NewCoins(sdk.NewInt64Coin("uatom", math.MaxInt64)), 1, "uatom"))
+}
+
+func TestTxPriorityWithOtherDenoms(t *testing.T) {
+	gaiaApp := helpers.Setup(t)
+	ctx := gaiaApp.NewUncachedContext(true, tmproto.Header{})
+	txConfig := gaiaApp.GetTxConfig()
+	bondDenom := gaiaApp.StakingKeeper.BondDenom(ctx)
+
+	// photon is required at a much lower gas price than the bond denom
+	globalfeeParams := globalfeetypes.DefaultParams()
+	globalfeeParams.MinimumGasPrices = sdk.NewDecCoins(
+		sdk.NewDecCoinFromDec(bondDenom, sdk.NewDecWithPrec(5, 3)),
+		sdk.NewDecCoinFromDec("photon", sdk.NewDecWithPrec(1, 6)),
+	)
+	gaiaApp.GetSubspace(globalfee.ModuleName).SetParamSet(ctx, &globalfeeParams)
+	feeDecorator := gaiafeeante.NewFeeDecorator(gaiaApp.GetSubspace(globalfee.ModuleName), gaiaApp.StakingKeeper)
+	next := func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) { return ctx, nil }
+
+	tests := []struct {
+		name     string
+		fee      sdk.Coins
+		priority int64
+	}{
+		{
+			name:     "bond denom at its required price",
+			fee:      sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 500)),
+			priority: 5000,
+		},
+		{
+			name: "other denom far above its required price",
+			fee:  sdk.NewCoins(sdk.NewInt64Coin("photon", 1_000_000_000)),
+		},
+		{
+			name:     "bond denom and other denom",
+			fee:      sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 500), sdk.NewInt64Coin("photon", 1_000_000_000)),
+			priority: 5000,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			txBuilder := txConfig.NewTxBuilder()
+			require.NoError(t, txBuilder.SetMsgs(&banktypes.MsgSend{}))
+			txBuilder.SetFeeAmount(tc.fee)
+			txBuilder.SetGasLimit(100_000)
+			tx := txBuilder.GetTx()
+
+			// the fee is accepted, but the priority is only given by the bond denom
+			_, err := feeDecorator.AnteHandle(ctx, tx, false, next)
+			require.NoError(t, err)
+			priority := ante.GetTxPriority(tc.fee, 100_000, bondDenom)
+			require.Equal(t, tc.priority, priority)
+
+			_, err = ante.NewMinTxPriorityDecorator(5000, feeDecorator).AnteHandle(ctx.WithPriority(priority), tx, false, next)
+			if tc.priority >= 5000 {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, gaiaerrors.ErrInsufficientFee)
+			}
+		})
+	}
+}
+
+func TestMinTxPriorityDecorator(t *testing.T) {
+	gaiaApp := helpers.Setup(t)
+	ctx := gaiaApp.NewUncachedContext(true, tmproto.Header{})
+	txConfig := gaiaApp.GetTxConfig()
+	feeDecorator := gaiafeeante.NewFeeDecorator(gaiaApp.GetSubspace(globalfee.ModuleName), gaiaApp.StakingKeeper)
+
+	send := &banktypes.MsgSend{}
+	updateClient := &ibcclienttypes.MsgUpdateClient{}
+	tests := []struct {
+		name        string
+		msg         sdk.Msg
+		gas         uint64
+		priority    int64
+		localBypass []string
+		checkTx     bool
+		expectPass  bool
+	}{
+		{
+			name:       "priority above the minimum",
+			msg:        send,
+			priority:   5000,
+			checkTx:    true,
+			expectPass: true,
+		},
+		{
+			name:     "priority below the minimum",
+			msg:      send,
+			priority: 4999,
+			checkTx:  true,
+		},
+		{
+			name:       "priority below the minimum in DeliverTx",
+			msg:        send,
+			priority:   4999,
+			expectPass: true,
+		},
+		{
+			name:       "global bypass msg",
+			msg:        updateClient,
+			gas:        100_000,
+			checkTx:    true,
+			expectPass: true,
+		},
+		{
+			name:    "global bypass msg above the bypass gas usage",
+			msg:     updateClient,
+			gas:     10_000_000,
+			checkTx: true,
+		},
+		{
+			name:        "local bypass msg",
+			msg:         send,
+			localBypass: []string{sdk.MsgTypeURL(send)},
+			checkTx:     true,
+			expectPass:  true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			txBuilder := txConfig.NewTxBuilder()
+			require.NoError(t, txBuilder.SetMsgs(tc.msg))
+			txBuilder.SetGasLimit(tc.gas)
+
+			decorator := ante.NewMinTxPriorityDecorator(5000, feeDecorator.WithLocalBypassMinFeeMsgTypes(tc.localBypass))
+			_, err := decorator.AnteHandle(
+				ctx.WithIsCheckTx(tc.checkTx).WithPriority(tc.priority),
+				txBuilder.GetTx(),
+				false,
+				func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) { return ctx, nil },
+			)
+			if tc.expectPass {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, gaiaerrors.ErrInsufficientFee)
+			}
+		})
+	}
+}
@@ -0,0 +1,142 @@
+package ante
+
+import (
+	"github.com/armon/go-metrics"
+
+	icacontrollertypes "github.com/cosmos/ibc-go/v7/modules/apps/27-interchain-accounts/controller/types"
+	icatypes "github.com/cosmos/ibc-go/v7/modules/apps/27-interchain-accounts/types"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+
+	gaiaerrors "github.com/cosmos/gaia/v17/types/errors"
+	gaiafeeante "github.com/cosmos/gaia/v17/x/globalfee/ante"
+)
+
+// metricTxLimitsRejectedTxs counts the txs rejected by the TxLimitsDecorator,
+// by reason.
+var metricTxLimitsRejectedTxs = []string{"ante", "tx_limits", "rejected_txs"}
+
+// TxLimitsDecorator rejects the txs over the maximum gas, msgs or nesting
+// depth set by governance. The msgs executed by an authz MsgExec and the msgs
+// of the payload of an interchain account MsgSendTx are counted recursively.
+// The gas limit is not checked in simulations.
+type TxLimitsDecorator struct {
+	txLimitKeeper TxLimitKeeper
+	cdc           codec.BinaryCodec
+}
+
+func NewTxLimitsDecorator(cdc codec.BinaryCodec, txLimitKeeper TxLimitKeeper) TxLimitsDecorator {
+	return TxLimitsDecorator{
+		txLimitKeeper: txLimitKeeper,
+		cdc:           cdc,
+	}
+}
+
+func (d TxLimitsDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, errorsmod.Wrap(gaiaerrors.ErrTxDecode, "Tx must implement the sdk.FeeTx interface")
+	}
+
+	params := d.txLimitKeeper.GetParams(ctx)
+	if !simulate && params.MaxTxGas > 0 && feeTx.GetGas() > params.MaxTxGas {
+		recordTxLimitRejection(ctx, "gas")
+		return ctx, errorsmod.Wrapf(gaiaerrors.ErrTxGasLimit, "tx gas limit %d exceeds the maximum %d", feeTx.GetGas(), params.MaxTxGas)
+	}
+
+	if params.MaxMsgsPerTx > 0 || params.MaxNestedDepth > 0 {
+		counter := msgCounter{cdc: d.cdc, maxMsgs: params.MaxMsgsPerTx, maxDepth: params.MaxNestedDepth}
+		if err := counter.count(feeTx.GetMsgs(), 0); err != nil {
+			switch {
+			case errorsmod.IsOf(err, gaiaerrors.ErrTooManyMsgs):
+				recordTxLimitRejection(ctx, "msgs")
+			case errorsmod.IsOf(err, gaiaerrors.ErrMsgsNestedTooDeep):
+				recordTxLimitRejection(ctx, "depth")
+			}
+			return ctx, err
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+func recordTxLimitRejection(ctx sdk.Context, reason string) {
+	telemetry.IncrCounterWithLabels(metricTxLimitsRejectedTxs, 1, []metrics.Label{
+		gaiafeeante.ModeLabel(ctx),
+		telemetry.NewLabel("reason", reason),
+	})
+}
+
+// msgCounter counts the msgs of a tx and their nested msgs, up to the
+// limits, 0 being no limit.
+type msgCounter struct {
+	cdc      codec.BinaryCodec
+	maxMsgs  uint64
+	maxDepth uint64
+
+	msgs uint64
+}
+
+// count adds the msgs at the given depth and their nested msgs.
+func (c *msgCounter) count(msgs []sdk.Msg, depth uint64) error {
+	if c.maxDepth > 0 && depth > c.maxDepth {
+		return errorsmod.Wrapf(gaiaerrors.ErrMsgsNestedTooDeep, "msgs nested at depth %d, the maximum is %d", depth, c.maxDepth)
+	}
+
+	for _, m := range msgs {
+		c.msgs++
+		if c.maxMsgs > 0 && c.msgs > c.maxMsgs {
+			return errorsmod.Wrapf(gaiaerrors.ErrTooManyMsgs, "tx has more than %d msgs", c.maxMsgs)
+		}
+
+		nested, err := c.nestedMsgs(m)
+		if err != nil {
+			return err
+		}
+		if len(nested) > 0 {
+			if err := c.count(nested, depth+1); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// nestedMsgs returns the msgs executed by an authz MsgExec or sent to an
+// interchain account. The msgs of an interchain account payload that are
+// unknown to the Hub, e.g. the msgs of the host chain, are counted without
+// their nested msgs.
+func (c *msgCounter) nestedMsgs(m sdk.Msg) ([]sdk.Msg, error) {
+	switch msg := m.(type) {
+	case *authz.MsgExec:
+		msgs, err := msg.GetMessages()
+		if err != nil {
+			return nil, errorsmod.Wrap(gaiaerrors.ErrTxDecode, "cannot unmarshal authz exec msgs")
+		}
+		return msgs, nil
+
+	case *icacontrollertypes.MsgSendTx:
+		// the payload is decoded without resolving its msgs, which may not
+		// be registered on the Hub
+		var cosmosTx icatypes.CosmosTx
+		if err := cosmosTx.Unmarshal(msg.PacketData.Data); err != nil {
+			// e.g. a payload encoded in JSON, counted as a single msg
+			return nil, nil
+		}
+		msgs := make([]sdk.Msg, len(cosmosTx.Messages))
+		for i, anyMsg := range cosmosTx.Messages {
+			// an unknown msg is left nil, it has no nested msgs
+			_ = c.cdc.UnpackAny(anyMsg, &msgs[i])
+		}
+		return msgs, nil
+
+	default:
+		return nil, nil
+	}
+}
@@ -0,0 +1,99 @@
+package ante_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/require"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	icacontrollertypes "github.com/cosmos/ibc-go/v7/modules/apps/27-interchain-accounts/controller/types"
+	icatypes "github.com/cosmos/ibc-go/v7/modules/apps/27-interchain-accounts/types"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/cosmos/gaia/v17/ante"
+	"github.com/cosmos/gaia/v17/app/helpers"
+	gaiaerrors "github.com/cosmos/gaia/v17/types/errors"
+	txlimittypes "github.com/cosmos/gaia/v17/x/txlimit/types"
+)
+
+func TestTxLimitsDecorator(t *testing.T) {
+	gaiaApp := helpers.Setup(t)
+	ctx := gaiaApp.NewUncachedContext(true, tmproto.Header{Height: 10})
+	txConfig := gaiaApp.GetTxConfig()
+	decorator := ante.NewTxLimitsDecorator(gaiaApp.AppCodec(), gaiaApp.TxLimitKeeper)
+
+	grantee := sdk.AccAddress("grantee_____________")
+	send := &banktypes.MsgSend{}
+	exec := func(msgs ...sdk.Msg) *authz.MsgExec {
+		msg := authz.NewMsgExec(grantee, msgs)
+		return &msg
+	}
+	sendTx := func(msgs ...proto.Message) *icacontrollertypes.MsgSendTx {
+		data, err := icatypes.SerializeCosmosTx(gaiaApp.AppCodec(), msgs)
+		require.NoError(t, err)
+		return &icacontrollertypes.MsgSendTx{PacketData: icatypes.InterchainAccountPacketData{Type: icatypes.EXECUTE_TX, Data: data}}
+	}
+	// a msg of the host chain, unknown to the Hub
+	unknownMsg := &codectypes.Any{TypeUrl: "/host.module.v1.MsgUnknown", Value: []byte{}}
+	unknownData, err := (&icatypes.CosmosTx{Messages: []*codectypes.Any{unknownMsg, unknownMsg}}).Marshal()
+	require.NoError(t, err)
+	sendUnknownTx := &icacontrollertypes.MsgSendTx{PacketData: icatypes.InterchainAccountPacketData{Type: icatypes.EXECUTE_TX, Data: unknownData}}
+
+	// 1_000_000 gas, 4 msgs and a depth of 1 at most
+	gaiaApp.TxLimitKeeper.SetParams(ctx, txlimittypes.NewParams(0, 0, 0, nil, 1_000_000, 4, 1))
+
+	tests := []struct {
+		name     string
+		msgs     []sdk.Msg
+		gas      uint64
+		simulate bool
+		err      error
+	}{
+		{name: "within the limits", msgs: []sdk.Msg{send, send, send, send}, gas: 1_000_000},
+		{name: "gas limit exceeded", msgs: []sdk.Msg{send}, gas: 1_000_001, err: gaiaerrors.ErrTxGasLimit},
+		{name: "gas limit not checked in simulations", msgs: []sdk.Msg{send}, gas: 1_000_001, simulate: true},
+		{name: "too many msgs", msgs: []sdk.Msg{send, send, send, send, send}, err: gaiaerrors.ErrTooManyMsgs},
+		{name: "authz exec msgs", msgs: []sdk.Msg{exec(send, send, send)}},
+		{name: "too many authz exec msgs", msgs: []sdk.Msg{send, exec(send, send, send)}, err: gaiaerrors.ErrTooManyMsgs},
+		{name: "nested authz exec", msgs: []sdk.Msg{exec(exec(send))}, err: gaiaerrors.ErrMsgsNestedTooDeep},
+		{name: "interchain account msgs", msgs: []sdk.Msg{sendTx(send, send, send)}},
+		{name: "too many interchain account msgs", msgs: []sdk.Msg{sendTx(send, send, send, send)}, err: gaiaerrors.ErrTooManyMsgs},
+		{name: "interchain account authz exec", msgs: []sdk.Msg{sendTx(exec(send))}, err: gaiaerrors.ErrMsgsNestedTooDeep},
+		{name: "unknown interchain account msgs", msgs: []sdk.Msg{sendUnknownTx, send}},
+		{name: "too many unknown interchain account msgs", msgs: []sdk.Msg{sendUnknownTx, send, send}, err: gaiaerrors.ErrTooManyMsgs},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			txBuilder := txConfig.NewTxBuilder()
+			require.NoError(t, txBuilder.SetMsgs(tc.msgs...))
+			txBuilder.SetGasLimit(tc.gas)
+
+			_, err := decorator.AnteHandle(
+				ctx,
+				txBuilder.GetTx(),
+				tc.simulate,
+				func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) { return ctx, nil },
+			)
+			if tc.err == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.err)
+			}
+		})
+	}
+
+	// no limit by default
+	gaiaApp.TxLimitKeeper.SetParams(ctx, txlimittypes.DefaultParams())
+	txBuilder := txConfig.NewTxBuilder()
+	require.NoError(t, txBuilder.SetMsgs(exec(exec(exec(send, send, send, send, send)))))
+	txBuilder.SetGasLimit(100_000_000)
+	_, err = decorator.AnteHandle(ctx, txBuilder.GetTx(), false, func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) { return ctx, nil })
+	require.NoError(t, err)
+}
@@ -19,6 +19,7 @@ import (
 	tmjson "github.com/cometbft/cometbft/libs/json"
 	"github.com/cometbft/cometbft/libs/log"
 	tmos "github.com/cometbft/cometbft/libs/os"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
 
 	ibctesting "github.com/cosmos/ibc-go/v7/testing"
 	providertypes "github.com/cosmos/interchain-security/v4/x/ccv/provider/types"
@@ -56,7 +57,9 @@ import (
 	"github.com/cosmos/gaia/v17/app/params"
 	"github.com/cosmos/gaia/v17/app/upgrades"
 	v17 "github.com/cosmos/gaia/v17/app/upgrades/v17"
+	gaiamempool "github.com/cosmos/gaia/v17/mempool"
 	"github.com/cosmos/gaia/v17/x/globalfee"
+	"github.com/cosmos/gaia/v17/x/pfmconfig"
 )
 
 var (
@@ -64,6 +67,10 @@ var (
 	DefaultNodeHome string
 
 	Upgrades = []upgrades.Upgrade{v17.Upgrade}
+
+	// Forks are height-gated state changes run at the beginning of a block,
+	// without a software upgrade proposal. Each fork is scoped to a chain ID.
+	Forks = []upgrades.Fork{}
 )
 
 var (
@@ -91,6 +98,9 @@ type GaiaApp struct { //nolint: revive
 	// simulation manager
 	sm           *module.SimulationManager
 	configurator module.Configurator
+
+	// the decorators of the ante handler
+	anteDecorators []gaiaante.NamedDecorator
 }
 
 func init() {
@@ -122,6 +132,26 @@ func NewGaiaApp(
 	// App Opts
 	skipGenesisInvariants := cast.ToBool(appOpts.Get(crisis.FlagSkipGenesisInvariants))
 	invCheckPeriod := cast.ToUint(appOpts.Get(server.FlagInvCheckPeriod))
+	gaiaConfig, err := GaiaConfigFromAppOptions(appOpts)
+	if err != nil {
+		panic(err)
+	}
+	if err := gaiaConfig.Validate(interfaceRegistry); err != nil {
+		panic(fmt.Errorf("invalid [gaia] config: %w", err))
+	}
+
+	if gaiaConfig.MempoolEnabled {
+		// The block proposals of the node are built from the Gaia mempool, but
+		// the proposals of the other validators are accepted as with the
+		// CometBFT mempool, as the mempool is a local setting.
+		baseAppOptions = append(baseAppOptions,
+			baseapp.SetMempool(gaiamempool.NewMempool(gaiamempool.Config{
+				MaxTxs:          gaiaConfig.MempoolMaxTxs,
+				MaxTxsPerSender: gaiaConfig.MempoolMaxTxsPerSender,
+			})),
+			func(app *baseapp.BaseApp) { app.SetProcessProposal(baseapp.NoOpProcessProposal()) },
+		)
+	}
 
 	bApp := baseapp.NewBaseApp(
 		appName,
@@ -214,7 +244,11 @@ func NewGaiaApp(
 	app.MountTransientStores(app.GetTransientStoreKey())
 	app.MountMemoryStores(app.GetMemoryStoreKey())
 
-	anteHandler, err := gaiaante.NewAnteHandler(
+	anteDecoratorsOpt, err := anteDecoratorsOptionFromAppOptions(appOpts)
+	if err != nil {
+		panic(err)
+	}
+	anteDecorators, err := gaiaante.NewAnteDecorators(
 		gaiaante.HandlerOptions{
 			HandlerOptions: ante.HandlerOptions{
 				AccountKeeper:   app.AccountKeeper,
@@ -223,20 +257,29 @@ func NewGaiaApp(
 				SignModeHandler: encodingConfig.TxConfig.SignModeHandler(),
 				SigGasConsumer:  ante.DefaultSigVerificationGasConsumer,
 			},
-			Codec:             appCodec,
-			IBCkeeper:         app.IBCKeeper,
-			GlobalFeeSubspace: app.GetSubspace(globalfee.ModuleName),
-			StakingKeeper:     app.StakingKeeper,
-			// If TxFeeChecker is nil the default ante TxFeeChecker is used
-			// so we use this no-op to keep the global fee module behaviour unchanged
-			TxFeeChecker: noOpTxFeeChecker,
+			Codec:                appCodec,
+			IBCkeeper:            app.IBCKeeper,
+			GlobalFeeSubspace:    app.GetSubspace(globalfee.ModuleName),
+			StakingKeeper:        app.StakingKeeper,
+			CircuitBreakerKeeper: app.CircuitBreakerKeeper,
+			TxLimitKeeper:        app.TxLimitKeeper,
+			// The fees are still checked by the globalfee FeeDecorator, this checker
+			// only sets the tx priority, computed by GetTxPriority
+			TxFeeChecker:                     app.txPriorityFeeChecker,
+			LocalBypassMinFeeMsgTypes:        gaiaConfig.BypassMinFeeMsgTypes,
+			MinTxPriority:                    gaiaConfig.MinTxPriority,
+			MetaprotocolsMaxExtensionOptions: gaiaConfig.MetaprotocolsMaxExtensionOptions,
+			MetaprotocolsMaxExtensionSize:    gaiaConfig.MetaprotocolsMaxExtensionSize,
+			ExtraDecorators:                  anteDecoratorsOpt.ExtraDecorators,
+			ModifyDecorators:                 anteDecoratorsOpt.ModifyDecorators,
 		},
 	)
 	if err != nil {
 		panic(fmt.Errorf("failed to create AnteHandler: %s", err))
 	}
 
-	app.SetAnteHandler(anteHandler)
+	app.anteDecorators = anteDecorators
+	app.SetAnteHandler(gaiaante.ChainDecorators(anteDecorators))
 	app.SetInitChainer(app.InitChainer)
 	app.SetBeginBlocker(app.BeginBlocker)
 	app.SetEndBlocker(app.EndBlocker)
@@ -244,20 +287,44 @@ func NewGaiaApp(
 	app.setupUpgradeHandlers()
 	app.setupUpgradeStoreLoaders()
 
+	if err := upgrades.ValidateForks(Forks); err != nil {
+		panic(fmt.Errorf("invalid forks: %w", err))
+	}
+	if err := upgrades.ValidateData(Upgrades); err != nil {
+		panic(fmt.Errorf("invalid upgrade data: %w", err))
+	}
+
 	if loadLatest {
 		if err := app.LoadLatestVersion(); err != nil {
 			tmos.Exit(fmt.Sprintf("failed to load latest version: %s", err))
 		}
+
+		app.logPFMConfig()
 	}
 
 	return app
 }
 
+// logPFMConfig logs the packet forward middleware settings in effect
+// at the latest committed height.
+func (app *GaiaApp) logPFMConfig() {
+	ctx := app.NewUncachedContext(true, tmproto.Header{Height: app.LastBlockHeight()})
+	params := pfmconfig.GetParams(ctx, app.GetSubspace(pfmconfig.ModuleName))
+
+	app.Logger().Info(
+		"packet forward middleware configured",
+		"retries_on_timeout", params.RetriesOnTimeout,
+		"forward_timeout", params.ForwardTimeout,
+		"refund_timeout", params.RefundTimeout,
+	)
+}
+
 // Name returns the name of the App
 func (app *GaiaApp) Name() string { return app.BaseApp.Name() }
 
 // BeginBlocker application updates every begin block
 func (app *GaiaApp) BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock) abci.ResponseBeginBlock {
+	BeginBlockForks(ctx, app)
 	return app.mm.BeginBlock(ctx, req)
 }
 
@@ -396,10 +463,15 @@ func (app *GaiaApp) setupUpgradeHandlers() {
 	for _, upgrade := range Upgrades {
 		app.UpgradeKeeper.SetUpgradeHandler(
 			upgrade.UpgradeName,
-			upgrade.CreateUpgradeHandler(
+			upgrades.WithPostUpgradeChecks(
+				upgrade.CreateUpgradeHandler(
+					app.mm,
+					app.configurator,
+					&app.AppKeepers,
+				),
 				app.mm,
-				app.configurator,
 				&app.AppKeepers,
+				upgrade.SupplyChange,
 			),
 		)
 	}
@@ -434,6 +506,37 @@ func (app *GaiaApp) GetTxConfig() client.TxConfig {
 	return app.txConfig
 }
 
+// AnteDecorators returns the decorators of the ante handler, in order.
+func (app *GaiaApp) AnteDecorators() []gaiaante.NamedDecorator {
+	return app.anteDecorators
+}
+
+// AnteDecoratorsOptionKey is the app options key of an AnteDecoratorsOption,
+// which customizes the decorators of the ante handler of the app.
+const AnteDecoratorsOptionKey = "gaia.ante-decorators"
+
+// AnteDecoratorsOption sets the ExtraDecorators and ModifyDecorators of the
+// ante handler options, see gaiaante.HandlerOptions.
+type AnteDecoratorsOption struct {
+	ExtraDecorators  map[gaiaante.InsertionPoint][]gaiaante.NamedDecorator
+	ModifyDecorators func(decorators []gaiaante.NamedDecorator) []gaiaante.NamedDecorator
+}
+
+// anteDecoratorsOptionFromAppOptions returns the AnteDecoratorsOption set in
+// the app options, if any.
+func anteDecoratorsOptionFromAppOptions(appOpts servertypes.AppOptions) (AnteDecoratorsOption, error) {
+	v := appOpts.Get(AnteDecoratorsOptionKey)
+	if v == nil {
+		return AnteDecoratorsOption{}, nil
+	}
+	opt, ok := v.(AnteDecoratorsOption)
+	if !ok {
+		return AnteDecoratorsOption{}, fmt.Errorf("invalid %s: expected %T, got %T", AnteDecoratorsOptionKey, AnteDecoratorsOption{}, v)
+	}
+
+	return opt, nil
+}
+
 // EmptyAppOptions is a stub implementing AppOptions
 type EmptyAppOptions struct{}
 
@@ -442,13 +545,14 @@ func (ao EmptyAppOptions) Get(_ string) interface{} {
 	return nil
 }
 
-// noOpTxFeeChecker is an ante TxFeeChecker for the DeductFeeDecorator, see x/auth/ante/fee.go,
-// it performs a no-op by not checking tx fees and always returns a zero tx priority
-func noOpTxFeeChecker(_ sdk.Context, tx sdk.Tx) (sdk.Coins, int64, error) {
+// txPriorityFeeChecker is an ante TxFeeChecker for the DeductFeeDecorator, see x/auth/ante/fee.go.
+// It does not check the tx fees, which the globalfee FeeDecorator does, and returns
+// the fee per gas in the bond denom, computed by GetTxPriority, as the tx priority
+func (app *GaiaApp) txPriorityFeeChecker(ctx sdk.Context, tx sdk.Tx) (sdk.Coins, int64, error) {
 	feeTx, ok := tx.(sdk.FeeTx)
 	if !ok {
 		return nil, 0, errorsmod.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
 	}
 
-	return feeTx.GetFee(), 0, nil
+	return feeTx.GetFee(), gaiaante.GetTxPriority(feeTx.GetFee(), feeTx.GetGas(), app.StakingKeeper.BondDenom(ctx)), nil
 }
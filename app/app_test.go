@@ -1,16 +1,25 @@
 package gaia_test
 
 import (
+	"encoding/json"
+	"errors"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 
 	db "github.com/cometbft/cometbft-db"
+	abci "github.com/cometbft/cometbft/abci/types"
 	"github.com/cometbft/cometbft/libs/log"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
 
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
+	gaiaante "github.com/cosmos/gaia/v17/ante"
 	gaia "github.com/cosmos/gaia/v17/app"
 	gaiahelpers "github.com/cosmos/gaia/v17/app/helpers"
 )
@@ -40,8 +49,131 @@ func TestGaiaApp_BlockedModuleAccountAddrs(t *testing.T) {
 	require.NotContains(t, blockedAddrs, authtypes.NewModuleAddress(govtypes.ModuleName).String())
 }
 
+type rejectDecorator struct{}
+
+func (rejectDecorator) AnteHandle(ctx sdk.Context, _ sdk.Tx, _ bool, _ sdk.AnteHandler) (sdk.Context, error) {
+	return ctx, errors.New("rejected by the injected decorator")
+}
+
+type noOpDecorator struct{}
+
+func (noOpDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	return next(ctx, tx, simulate)
+}
+
+func TestGaiaApp_AnteDecoratorsOption(t *testing.T) {
+	encConfig := gaia.RegisterEncodingConfig()
+	newApp := func(opt interface{}) *gaia.GaiaApp {
+		return gaia.NewGaiaApp(
+			log.NewNopLogger(),
+			db.NewMemDB(),
+			nil,
+			true,
+			map[int64]bool{},
+			gaia.DefaultNodeHome,
+			encConfig,
+			simtestutil.AppOptionsMap{gaia.AnteDecoratorsOptionKey: opt},
+		)
+	}
+
+	app := newApp(gaia.AnteDecoratorsOption{
+		ExtraDecorators: map[gaiaante.InsertionPoint][]gaiaante.NamedDecorator{
+			gaiaante.PostSig: {{Name: "injected-post-sig", Decorator: noOpDecorator{}}},
+		},
+		// the reject decorator runs right after the context is set up
+		ModifyDecorators: func(decorators []gaiaante.NamedDecorator) []gaiaante.NamedDecorator {
+			return append([]gaiaante.NamedDecorator{decorators[0], {Name: "injected-reject", Decorator: rejectDecorator{}}}, decorators[1:]...)
+		},
+	})
+
+	names := make([]string, 0, len(app.AnteDecorators()))
+	for _, d := range app.AnteDecorators() {
+		names = append(names, d.Name)
+	}
+	require.Equal(t, "injected-reject", names[1])
+	require.Contains(t, names, "injected-post-sig")
+
+	addr := sdk.AccAddress("injected-decorator__")
+	txBuilder := encConfig.TxConfig.NewTxBuilder()
+	require.NoError(t, txBuilder.SetMsgs(banktypes.NewMsgSend(addr, addr, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1)))))
+	txBytes, err := encConfig.TxConfig.TxEncoder()(txBuilder.GetTx())
+	require.NoError(t, err)
+
+	res := app.CheckTx(abci.RequestCheckTx{Tx: txBytes})
+	require.False(t, res.IsOK())
+	require.Contains(t, res.Log, "rejected by the injected decorator")
+
+	require.Panics(t, func() { newApp("invalid") })
+}
+
 func TestGaiaApp_Export(t *testing.T) {
 	app := gaiahelpers.Setup(t)
 	_, err := app.ExportAppStateAndValidators(true, []string{}, []string{})
 	require.NoError(t, err, "ExportAppStateAndValidators should not have an error")
 }
+
+func TestGaiaApp_ExportStream(t *testing.T) {
+	app := gaiahelpers.Setup(t)
+
+	exported, err := app.ExportAppStateAndValidators(false, []string{}, []string{})
+	require.NoError(t, err)
+	var appState map[string]json.RawMessage
+	require.NoError(t, json.Unmarshal(exported.AppState, &appState))
+
+	modules, err := app.ModulesToExport(nil)
+	require.NoError(t, err)
+	require.Len(t, modules, len(appState))
+
+	streamed := make(map[string]json.RawMessage)
+	streamExported, err := app.ExportAppStateStream(false, []string{}, modules, func(module string, genesis json.RawMessage) error {
+		streamed[module] = genesis
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, exported.Height, streamExported.Height)
+	require.Equal(t, exported.Validators, streamExported.Validators)
+	require.Len(t, streamed, len(appState))
+	for module, genesis := range appState {
+		// a module without genesis, e.g. params, is exported as null
+		streamedGenesis, err := json.Marshal(streamed[module])
+		require.NoError(t, err)
+		require.JSONEq(t, string(genesis), string(streamedGenesis), module)
+	}
+
+	modules, err = app.ModulesToExport([]string{"staking", "bank"})
+	require.NoError(t, err)
+	require.Equal(t, []string{"bank", "staking"}, modules)
+
+	_, err = app.ModulesToExport([]string{"unknown"})
+	require.ErrorContains(t, err, "module unknown does not exist")
+}
+
+func TestGaiaApp_ZeroHeightDryRun(t *testing.T) {
+	app := gaiahelpers.Setup(t)
+	ctx := app.NewContext(true, tmproto.Header{Height: app.LastBlockHeight()})
+	validators := app.StakingKeeper.GetAllValidators(ctx)
+	require.Len(t, validators, 1)
+
+	report, err := app.ZeroHeightDryRun(nil)
+	require.NoError(t, err)
+	require.Empty(t, report.Jailed)
+	require.Equal(t, 1, report.HeightResets.Validators)
+
+	// validators missing from the allow list are jailed
+	report, err = app.ZeroHeightDryRun([]string{sdk.ValAddress("other-validator").String()})
+	require.NoError(t, err)
+	require.Equal(t, []gaia.ValidatorJailChange{
+		{Operator: validators[0].OperatorAddress, Moniker: validators[0].GetMoniker(), Before: false, After: true},
+	}, report.Jailed)
+
+	// the state is left untouched
+	validator, found := app.StakingKeeper.GetValidator(ctx, validators[0].GetOperator())
+	require.True(t, found)
+	require.False(t, validator.Jailed)
+
+	_, err = app.ZeroHeightDryRun([]string{"invalid"})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidAddress)
+
+	_, err = app.ExportAppStateAndValidators(true, []string{"invalid"}, []string{})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidAddress)
+}
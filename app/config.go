@@ -0,0 +1,172 @@
+package gaia
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cast"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+)
+
+// GaiaConfigTemplate is the app.toml template of the [gaia] section.
+const GaiaConfigTemplate = `
+###############################################################################
+###                           Gaia Configuration                            ###
+###############################################################################
+
+# The settings of this section are local to the node and only apply in
+# CheckTx, i.e. they decide which transactions the node admits to its mempool,
+# not which transactions are valid in a block.
+[gaia]
+
+# Message types of the transactions that only pay the global fees, and not
+# the minimum-gas-prices of the node. Unlike the bypass message types of the
+# globalfee params, they do not waive the global fees.
+#
+# Example:
+# ["/ibc.core.client.v1.MsgUpdateClient", "/ibc.core.channel.v1.MsgRecvPacket"]
+bypass-min-fee-msg-types = [{{ range .Gaia.BypassMinFeeMsgTypes }}{{ printf "%q, " . }}{{end}}]
+
+# The minimum priority of a transaction, its fee per gas in the bond denom in
+# millionths, e.g. 5000 for 0.005uatom per gas. The transactions allowed to bypass the
+# minimum fees are exempted. 0 disables the check.
+min-tx-priority = {{ .Gaia.MinTxPriority }}
+
+# The maximum number of metaprotocols extension options of a transaction,
+# 0 means no limit.
+metaprotocols-max-extension-options = {{ .Gaia.MetaprotocolsMaxExtensionOptions }}
+
+# The maximum size in bytes of the metaprotocols extension options of a
+# transaction, 0 means no limit.
+metaprotocols-max-extension-size = {{ .Gaia.MetaprotocolsMaxExtensionSize }}
+
+# Enables the Gaia app-side mempool, which orders the transactions by priority
+# and by nonce for each sender. The block proposals of the node are built from
+# it instead of from the CometBFT mempool.
+mempool-enabled = {{ .Gaia.MempoolEnabled }}
+
+# The maximum number of transactions in the Gaia mempool. Beyond, the
+# transaction with the lowest priority is evicted for a transaction with a
+# higher priority. 0 means no limit.
+mempool-max-txs = {{ .Gaia.MempoolMaxTxs }}
+
+# The maximum number of pending transactions of a sender in the Gaia mempool,
+# 0 means no limit.
+mempool-max-txs-per-sender = {{ .Gaia.MempoolMaxTxsPerSender }}
+`
+
+const (
+	flagGaiaBypassMinFeeMsgTypes             = "gaia.bypass-min-fee-msg-types"
+	flagGaiaMinTxPriority                    = "gaia.min-tx-priority"
+	flagGaiaMetaprotocolsMaxExtensionOptions = "gaia.metaprotocols-max-extension-options"
+	flagGaiaMetaprotocolsMaxExtensionSize    = "gaia.metaprotocols-max-extension-size"
+	flagGaiaMempoolEnabled                   = "gaia.mempool-enabled"
+	flagGaiaMempoolMaxTxs                    = "gaia.mempool-max-txs"
+	flagGaiaMempoolMaxTxsPerSender           = "gaia.mempool-max-txs-per-sender"
+)
+
+// GaiaConfig defines the node-local settings of the [gaia] section of app.toml.
+type GaiaConfig struct {
+	// BypassMinFeeMsgTypes are the msg types of the txs that only pay the
+	// global fees in CheckTx.
+	BypassMinFeeMsgTypes []string `mapstructure:"bypass-min-fee-msg-types"`
+	// MinTxPriority is the minimum priority of the txs in CheckTx.
+	MinTxPriority int64 `mapstructure:"min-tx-priority"`
+	// MetaprotocolsMaxExtensionOptions is the maximum number of non-critical
+	// extension options of the txs in CheckTx.
+	MetaprotocolsMaxExtensionOptions uint64 `mapstructure:"metaprotocols-max-extension-options"`
+	// MetaprotocolsMaxExtensionSize is the maximum size of the non-critical
+	// extension options of the txs in CheckTx.
+	MetaprotocolsMaxExtensionSize uint64 `mapstructure:"metaprotocols-max-extension-size"`
+	// MempoolEnabled enables the Gaia app-side mempool.
+	MempoolEnabled bool `mapstructure:"mempool-enabled"`
+	// MempoolMaxTxs is the maximum number of txs in the mempool.
+	MempoolMaxTxs int `mapstructure:"mempool-max-txs"`
+	// MempoolMaxTxsPerSender is the maximum number of pending txs of a sender.
+	MempoolMaxTxsPerSender int `mapstructure:"mempool-max-txs-per-sender"`
+}
+
+// DefaultGaiaConfig returns the default [gaia] settings, which add no check.
+func DefaultGaiaConfig() GaiaConfig {
+	return GaiaConfig{
+		BypassMinFeeMsgTypes:   []string{},
+		MempoolMaxTxs:          5000,
+		MempoolMaxTxsPerSender: 100,
+	}
+}
+
+// GaiaConfigFromAppOptions reads the [gaia] settings from the app options.
+func GaiaConfigFromAppOptions(appOpts servertypes.AppOptions) (GaiaConfig, error) {
+	cfg := DefaultGaiaConfig()
+
+	var err error
+	if v := appOpts.Get(flagGaiaBypassMinFeeMsgTypes); v != nil {
+		if cfg.BypassMinFeeMsgTypes, err = cast.ToStringSliceE(v); err != nil {
+			return GaiaConfig{}, fmt.Errorf("invalid %s: %w", flagGaiaBypassMinFeeMsgTypes, err)
+		}
+	}
+	if v := appOpts.Get(flagGaiaMinTxPriority); v != nil {
+		if cfg.MinTxPriority, err = cast.ToInt64E(v); err != nil {
+			return GaiaConfig{}, fmt.Errorf("invalid %s: %w", flagGaiaMinTxPriority, err)
+		}
+	}
+	if v := appOpts.Get(flagGaiaMetaprotocolsMaxExtensionOptions); v != nil {
+		if cfg.MetaprotocolsMaxExtensionOptions, err = cast.ToUint64E(v); err != nil {
+			return GaiaConfig{}, fmt.Errorf("invalid %s: %w", flagGaiaMetaprotocolsMaxExtensionOptions, err)
+		}
+	}
+	if v := appOpts.Get(flagGaiaMetaprotocolsMaxExtensionSize); v != nil {
+		if cfg.MetaprotocolsMaxExtensionSize, err = cast.ToUint64E(v); err != nil {
+			return GaiaConfig{}, fmt.Errorf("invalid %s: %w", flagGaiaMetaprotocolsMaxExtensionSize, err)
+		}
+	}
+
+	if v := appOpts.Get(flagGaiaMempoolEnabled); v != nil {
+		if cfg.MempoolEnabled, err = cast.ToBoolE(v); err != nil {
+			return GaiaConfig{}, fmt.Errorf("invalid %s: %w", flagGaiaMempoolEnabled, err)
+		}
+	}
+	if v := appOpts.Get(flagGaiaMempoolMaxTxs); v != nil {
+		if cfg.MempoolMaxTxs, err = cast.ToIntE(v); err != nil {
+			return GaiaConfig{}, fmt.Errorf("invalid %s: %w", flagGaiaMempoolMaxTxs, err)
+		}
+	}
+	if v := appOpts.Get(flagGaiaMempoolMaxTxsPerSender); v != nil {
+		if cfg.MempoolMaxTxsPerSender, err = cast.ToIntE(v); err != nil {
+			return GaiaConfig{}, fmt.Errorf("invalid %s: %w", flagGaiaMempoolMaxTxsPerSender, err)
+		}
+	}
+
+	return cfg, nil
+}
+
+// Validate checks that the msg types are known to the interface registry and
+// that the limits are valid.
+func (c GaiaConfig) Validate(interfaceRegistry codectypes.InterfaceRegistry) error {
+	seen := make(map[string]bool, len(c.BypassMinFeeMsgTypes))
+	for _, msgType := range c.BypassMinFeeMsgTypes {
+		if !strings.HasPrefix(msgType, "/") {
+			return fmt.Errorf("invalid bypass msg type %q: must start with '/'", msgType)
+		}
+		if seen[msgType] {
+			return fmt.Errorf("duplicate bypass msg type %q", msgType)
+		}
+		seen[msgType] = true
+		if _, err := interfaceRegistry.Resolve(msgType); err != nil {
+			return fmt.Errorf("unknown bypass msg type %q", msgType)
+		}
+	}
+	if c.MinTxPriority < 0 {
+		return fmt.Errorf("min tx priority cannot be negative, got %d", c.MinTxPriority)
+	}
+	if c.MempoolMaxTxs < 0 {
+		return fmt.Errorf("mempool max txs cannot be negative, got %d", c.MempoolMaxTxs)
+	}
+	if c.MempoolMaxTxsPerSender < 0 {
+		return fmt.Errorf("mempool max txs per sender cannot be negative, got %d", c.MempoolMaxTxsPerSender)
+	}
+
+	return nil
+}
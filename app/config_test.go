@@ -0,0 +1,57 @@
+package gaia_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+
+	gaia "github.com/cosmos/gaia/v17/app"
+)
+
+func TestGaiaConfig(t *testing.T) {
+	interfaceRegistry := gaia.RegisterEncodingConfig().InterfaceRegistry
+
+	cfg, err := gaia.GaiaConfigFromAppOptions(simtestutil.AppOptionsMap{})
+	require.NoError(t, err)
+	require.Equal(t, gaia.DefaultGaiaConfig(), cfg)
+	require.NoError(t, cfg.Validate(interfaceRegistry))
+
+	cfg, err = gaia.GaiaConfigFromAppOptions(simtestutil.AppOptionsMap{
+		"gaia.bypass-min-fee-msg-types":            []interface{}{"/ibc.core.client.v1.MsgUpdateClient"},
+		"gaia.min-tx-priority":                     "5000",
+		"gaia.metaprotocols-max-extension-options": 2,
+		"gaia.metaprotocols-max-extension-size":    int64(1024),
+		"gaia.mempool-enabled":                     true,
+		"gaia.mempool-max-txs":                     "10000",
+		"gaia.mempool-max-txs-per-sender":          0,
+	})
+	require.NoError(t, err)
+	require.Equal(t, gaia.GaiaConfig{
+		BypassMinFeeMsgTypes:             []string{"/ibc.core.client.v1.MsgUpdateClient"},
+		MinTxPriority:                    5000,
+		MetaprotocolsMaxExtensionOptions: 2,
+		MetaprotocolsMaxExtensionSize:    1024,
+		MempoolEnabled:                   true,
+		MempoolMaxTxs:                    10000,
+	}, cfg)
+	require.NoError(t, cfg.Validate(interfaceRegistry))
+
+	_, err = gaia.GaiaConfigFromAppOptions(simtestutil.AppOptionsMap{"gaia.min-tx-priority": "high"})
+	require.ErrorContains(t, err, "invalid gaia.min-tx-priority")
+
+	for _, tc := range []struct {
+		cfg gaia.GaiaConfig
+		err string
+	}{
+		{gaia.GaiaConfig{BypassMinFeeMsgTypes: []string{"cosmos.bank.v1beta1.MsgSend"}}, "must start with '/'"},
+		{gaia.GaiaConfig{BypassMinFeeMsgTypes: []string{"/cosmos.bank.v1beta1.MsgSend", "/cosmos.bank.v1beta1.MsgSend"}}, "duplicate bypass msg type"},
+		{gaia.GaiaConfig{BypassMinFeeMsgTypes: []string{"/cosmos.bank.v1beta1.MsgUnknown"}}, "unknown bypass msg type"},
+		{gaia.GaiaConfig{MinTxPriority: -1}, "min tx priority cannot be negative"},
+		{gaia.GaiaConfig{MempoolMaxTxs: -1}, "mempool max txs cannot be negative"},
+		{gaia.GaiaConfig{MempoolMaxTxsPerSender: -1}, "mempool max txs per sender cannot be negative"},
+	} {
+		require.ErrorContains(t, tc.cfg.Validate(interfaceRegistry), tc.err)
+	}
+}
@@ -2,14 +2,24 @@ package gaia
 
 import (
 	"encoding/json"
+	"errors"
+	"fmt"
 
 	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
 
+	errorsmod "cosmossdk.io/errors"
+
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/cosmos/gaia/v17/app/upgrades"
+	gaiaerrors "github.com/cosmos/gaia/v17/types/errors"
 )
 
 // ExportAppStateAndValidators exports the state of the application for a genesis
@@ -19,15 +29,9 @@ func (app *GaiaApp) ExportAppStateAndValidators(
 	jailAllowedAddrs []string,
 	modulesToExport []string,
 ) (servertypes.ExportedApp, error) {
-	// as if they could withdraw from the start of the next block
-	ctx := app.NewContext(true, tmproto.Header{Height: app.LastBlockHeight()})
-
-	// We export at last height + 1, because that's the height at which
-	// Tendermint will start InitChain.
-	height := app.LastBlockHeight() + 1
-	if forZeroHeight {
-		height = 0
-		app.prepForZeroHeightGenesis(ctx, jailAllowedAddrs)
+	ctx, height, err := app.exportContext(forZeroHeight, jailAllowedAddrs)
+	if err != nil {
+		return servertypes.ExportedApp{}, err
 	}
 
 	genState := app.mm.ExportGenesisForModules(ctx, app.appCodec, modulesToExport)
@@ -45,10 +49,153 @@ func (app *GaiaApp) ExportAppStateAndValidators(
 	}, err
 }
 
-// prepare for fresh start at zero height
+// ModulesToExport returns the given modules in export genesis order, or all
+// the modules if none is given.
+func (app *GaiaApp) ModulesToExport(modulesToExport []string) ([]string, error) {
+	selected := make(map[string]bool, len(modulesToExport))
+	for _, name := range modulesToExport {
+		if _, ok := app.mm.Modules[name]; !ok {
+			return nil, fmt.Errorf("module %s does not exist", name)
+		}
+		selected[name] = true
+	}
+
+	var modules []string
+	for _, name := range app.mm.OrderExportGenesis {
+		if _, ok := app.mm.Modules[name].(module.HasGenesis); ok && (len(selected) == 0 || selected[name]) {
+			modules = append(modules, name)
+		}
+	}
+
+	return modules, nil
+}
+
+// ExportAppStateStream exports the state of the application like
+// ExportAppStateAndValidators, but passes the genesis of the given modules to
+// write one module at a time, so that the whole app state is never held in
+// memory. The returned ExportedApp has no AppState.
+func (app *GaiaApp) ExportAppStateStream(
+	forZeroHeight bool,
+	jailAllowedAddrs []string,
+	modules []string,
+	write func(module string, genesis json.RawMessage) error,
+) (servertypes.ExportedApp, error) {
+	ctx, height, err := app.exportContext(forZeroHeight, jailAllowedAddrs)
+	if err != nil {
+		return servertypes.ExportedApp{}, err
+	}
+
+	for _, name := range modules {
+		m, ok := app.mm.Modules[name].(module.HasGenesis)
+		if !ok {
+			return servertypes.ExportedApp{}, fmt.Errorf("module %s has no genesis to export", name)
+		}
+		if err := write(name, m.ExportGenesis(ctx, app.appCodec)); err != nil {
+			return servertypes.ExportedApp{}, fmt.Errorf("failed to write the genesis of module %s: %w", name, err)
+		}
+	}
+
+	validators, err := staking.WriteValidators(ctx, app.StakingKeeper)
+	return servertypes.ExportedApp{
+		Validators:      validators,
+		Height:          height,
+		ConsensusParams: app.BaseApp.GetConsensusParams(ctx),
+	}, err
+}
+
+// exportContext returns the context to export the state from and the height
+// of the exported genesis.
+func (app *GaiaApp) exportContext(forZeroHeight bool, jailAllowedAddrs []string) (sdk.Context, int64, error) {
+	// as if they could withdraw from the start of the next block
+	ctx := app.NewContext(true, tmproto.Header{Height: app.LastBlockHeight()})
+
+	// We export at last height + 1, because that's the height at which
+	// Tendermint will start InitChain.
+	height := app.LastBlockHeight() + 1
+	if forZeroHeight {
+		height = 0
+		if err := app.prepForZeroHeightGenesis(ctx, jailAllowedAddrs, &ZeroHeightReport{}); err != nil {
+			return ctx, height, err
+		}
+	}
+
+	return ctx, height, nil
+}
+
+// ZeroHeightReport lists the changes made to the state when it is exported
+// for a chain restarting at height zero.
+type ZeroHeightReport struct {
+	// Height is the height of the exported state
+	Height int64 `json:"height"`
+	// Jailed are the validators whose jailed status changed
+	Jailed []ValidatorJailChange `json:"jailed"`
+	// Commission is the commission withdrawn from each validator
+	Commission []CommissionWithdrawal `json:"commission"`
+	// Rewards are the rewards withdrawn by each delegation
+	Rewards []RewardsWithdrawal `json:"rewards"`
+	// TotalCommission and TotalRewards are the sums of all withdrawals
+	TotalCommission sdk.Coins `json:"total_commission"`
+	TotalRewards    sdk.Coins `json:"total_rewards"`
+	// CommunityPoolDonation holds the outstanding reward fractions left after
+	// the withdrawals and donated to the community pool
+	CommunityPoolDonation sdk.DecCoins `json:"community_pool_donation"`
+	// HeightResets counts the entries whose height is reset to zero
+	HeightResets HeightResets `json:"height_resets"`
+}
+
+// ValidatorJailChange is a change of the jailed status of a validator.
+type ValidatorJailChange struct {
+	Operator string `json:"operator"`
+	Moniker  string `json:"moniker"`
+	Before   bool   `json:"before"`
+	After    bool   `json:"after"`
+}
+
+// CommissionWithdrawal is the commission withdrawn from a validator.
+type CommissionWithdrawal struct {
+	Validator string    `json:"validator"`
+	Amount    sdk.Coins `json:"amount"`
+}
+
+// RewardsWithdrawal are the rewards withdrawn by a delegation.
+type RewardsWithdrawal struct {
+	Delegator string    `json:"delegator"`
+	Validator string    `json:"validator"`
+	Amount    sdk.Coins `json:"amount"`
+}
+
+// HeightResets counts, per kind of entry, the entries whose height is reset.
+type HeightResets struct {
+	Redelegations        int `json:"redelegations"`
+	UnbondingDelegations int `json:"unbonding_delegations"`
+	Validators           int `json:"validators"`
+	SigningInfos         int `json:"signing_infos"`
+}
+
+// ZeroHeightDryRun prepares the latest state for a zero height export, as
+// ExportAppStateAndValidators does, on a branch of the state that is
+// discarded, and returns the changes it made.
+func (app *GaiaApp) ZeroHeightDryRun(jailAllowedAddrs []string) (ZeroHeightReport, error) {
+	ctx := app.NewContext(true, tmproto.Header{Height: app.LastBlockHeight()})
+	cacheCtx, _ := ctx.CacheContext()
+
+	report := ZeroHeightReport{Height: app.LastBlockHeight()}
+	err := app.prepForZeroHeightGenesis(cacheCtx, jailAllowedAddrs, &report)
+
+	return report, err
+}
+
+// prepare for fresh start at zero height, recording the changes in report
 // NOTE zero height genesis is a temporary feature which will be deprecated
 // in favour of export at a block height
-func (app *GaiaApp) prepForZeroHeightGenesis(ctx sdk.Context, jailAllowedAddrs []string) {
+func (app *GaiaApp) prepForZeroHeightGenesis(ctx sdk.Context, jailAllowedAddrs []string, report *ZeroHeightReport) (err error) {
+	// the keepers panic on inconsistent state
+	defer func() {
+		if r := recover(); r != nil {
+			err = errorsmod.Wrapf(gaiaerrors.ErrLogic, "failed to prepare the state for a zero height export: %v", r)
+		}
+	}()
+
 	applyAllowedAddrs := false
 
 	// check if there is a allowed address list
@@ -61,41 +208,60 @@ func (app *GaiaApp) prepForZeroHeightGenesis(ctx sdk.Context, jailAllowedAddrs [
 	for _, addr := range jailAllowedAddrs {
 		_, err := sdk.ValAddressFromBech32(addr)
 		if err != nil {
-			panic(err)
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid jail allowed address %s: %s", addr, err)
 		}
 		allowedAddrsMap[addr] = true
 	}
 
 	/* Just to be safe, assert the invariants on current state. */
-	app.CrisisKeeper.AssertInvariants(ctx)
+	if err := upgrades.CheckInvariants(ctx, &app.AppKeepers); err != nil {
+		return errorsmod.Wrap(gaiaerrors.ErrLogic, err.Error())
+	}
 
 	/* Handle fee distribution state. */
 
 	// withdraw all validator commission
 	app.StakingKeeper.IterateValidators(ctx, func(_ int64, val stakingtypes.ValidatorI) (stop bool) {
-		_, err := app.DistrKeeper.WithdrawValidatorCommission(ctx, val.GetOperator())
+		var commission sdk.Coins
+		commission, err = app.DistrKeeper.WithdrawValidatorCommission(ctx, val.GetOperator())
+		if errors.Is(err, distrtypes.ErrNoValidatorCommission) {
+			err = nil
+			return false
+		}
 		if err != nil {
-			app.Logger().Error(err.Error(), "ValOperatorAddress", val.GetOperator())
+			err = errorsmod.Wrapf(err, "failed to withdraw the commission of validator %s", val.GetOperator())
+			return true
 		}
+
+		report.Commission = append(report.Commission, CommissionWithdrawal{Validator: val.GetOperator().String(), Amount: commission})
+		report.TotalCommission = report.TotalCommission.Add(commission...)
 		return false
 	})
+	if err != nil {
+		return err
+	}
 
 	// withdraw all delegator rewards
 	dels := app.StakingKeeper.GetAllDelegations(ctx)
 	for _, delegation := range dels {
 		valAddr, err := sdk.ValAddressFromBech32(delegation.ValidatorAddress)
 		if err != nil {
-			panic(err)
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid validator address of delegation: %s", err)
 		}
 
 		delAddr, err := sdk.AccAddressFromBech32(delegation.DelegatorAddress)
 		if err != nil {
-			panic(err)
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid delegator address of delegation: %s", err)
 		}
 
-		_, err = app.DistrKeeper.WithdrawDelegationRewards(ctx, delAddr, valAddr)
+		rewards, err := app.DistrKeeper.WithdrawDelegationRewards(ctx, delAddr, valAddr)
 		if err != nil {
-			panic(err)
+			return errorsmod.Wrapf(err, "failed to withdraw the rewards of delegation from %s to %s", delAddr, valAddr)
+		}
+
+		if !rewards.IsZero() {
+			report.Rewards = append(report.Rewards, RewardsWithdrawal{Delegator: delAddr.String(), Validator: valAddr.String(), Amount: rewards})
+			report.TotalRewards = report.TotalRewards.Add(rewards...)
 		}
 	}
 
@@ -131,28 +297,33 @@ func (app *GaiaApp) prepForZeroHeightGenesis(ctx sdk.Context, jailAllowedAddrs [
 		feePool := app.DistrKeeper.GetFeePool(ctx)
 		feePool.CommunityPool = feePool.CommunityPool.Add(scraps...)
 		app.DistrKeeper.SetFeePool(ctx, feePool)
+		report.CommunityPoolDonation = report.CommunityPoolDonation.Add(scraps...)
 
-		if err := app.DistrKeeper.Hooks().AfterValidatorCreated(ctx, val.GetOperator()); err != nil {
-			panic(err)
+		if err = app.DistrKeeper.Hooks().AfterValidatorCreated(ctx, val.GetOperator()); err != nil {
+			err = errorsmod.Wrapf(err, "failed to reinitialize validator %s", val.GetOperator())
+			return true
 		}
 		return false
 	})
+	if err != nil {
+		return err
+	}
 
 	// reinitialize all delegations
 	for _, del := range dels {
 		valAddr, err := sdk.ValAddressFromBech32(del.ValidatorAddress)
 		if err != nil {
-			panic(err)
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid validator address of delegation: %s", err)
 		}
 		delAddr, err := sdk.AccAddressFromBech32(del.DelegatorAddress)
 		if err != nil {
-			panic(err)
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid delegator address of delegation: %s", err)
 		}
 		if err := app.DistrKeeper.Hooks().BeforeDelegationCreated(ctx, delAddr, valAddr); err != nil {
-			panic(err)
+			return errorsmod.Wrapf(err, "failed to reinitialize delegation from %s to %s", delAddr, valAddr)
 		}
 		if err := app.DistrKeeper.Hooks().AfterDelegationModified(ctx, delAddr, valAddr); err != nil {
-			panic(err)
+			return errorsmod.Wrapf(err, "failed to reinitialize delegation from %s to %s", delAddr, valAddr)
 		}
 	}
 
@@ -167,6 +338,7 @@ func (app *GaiaApp) prepForZeroHeightGenesis(ctx sdk.Context, jailAllowedAddrs [
 			red.Entries[i].CreationHeight = 0
 		}
 		app.StakingKeeper.SetRedelegation(ctx, red)
+		report.HeightResets.Redelegations++
 		return false
 	})
 
@@ -176,6 +348,7 @@ func (app *GaiaApp) prepForZeroHeightGenesis(ctx sdk.Context, jailAllowedAddrs [
 			ubd.Entries[i].CreationHeight = 0
 		}
 		app.StakingKeeper.SetUnbondingDelegation(ctx, ubd)
+		report.HeightResets.UnbondingDelegations++
 		return false
 	})
 
@@ -184,31 +357,43 @@ func (app *GaiaApp) prepForZeroHeightGenesis(ctx sdk.Context, jailAllowedAddrs [
 	store := ctx.KVStore(app.GetKey(stakingtypes.StoreKey))
 	iter := sdk.KVStoreReversePrefixIterator(store, stakingtypes.ValidatorsKey)
 
-	counter := int16(0)
-
 	// Closure to ensure iterator doesn't leak.
-	func() {
+	if err := func() error {
 		defer iter.Close()
 		for ; iter.Valid(); iter.Next() {
 			addr := sdk.ValAddress(stakingtypes.AddressFromValidatorsKey(iter.Key()))
 			validator, found := app.StakingKeeper.GetValidator(ctx, addr)
 			if !found {
-				panic("expected validator, not found")
+				return errorsmod.Wrapf(gaiaerrors.ErrNotFound, "validator %s", addr)
 			}
 
 			validator.UnbondingHeight = 0
-			if applyAllowedAddrs && !allowedAddrsMap[addr.String()] {
+			jailed := validator.Jailed
+			if applyAllowedAddrs && !allowedAddrsMap[addr.String()] && !validator.Jailed {
+				// jailed validators are not part of the power index, as when
+				// jailed by the staking keeper
+				app.StakingKeeper.DeleteValidatorByPowerIndex(ctx, validator)
 				validator.Jailed = true
 			}
+			if validator.Jailed != jailed {
+				report.Jailed = append(report.Jailed, ValidatorJailChange{
+					Operator: addr.String(),
+					Moniker:  validator.GetMoniker(),
+					Before:   jailed,
+					After:    validator.Jailed,
+				})
+			}
 
 			app.StakingKeeper.SetValidator(ctx, validator)
-			counter++
+			report.HeightResets.Validators++
 		}
-	}()
+		return nil
+	}(); err != nil {
+		return err
+	}
 
-	_, err := app.StakingKeeper.ApplyAndReturnValidatorSetUpdates(ctx)
-	if err != nil {
-		panic(err)
+	if _, err := app.StakingKeeper.ApplyAndReturnValidatorSetUpdates(ctx); err != nil {
+		return errorsmod.Wrap(err, "failed to apply the validator set updates")
 	}
 
 	/* Handle slashing state. */
@@ -219,7 +404,10 @@ func (app *GaiaApp) prepForZeroHeightGenesis(ctx sdk.Context, jailAllowedAddrs [
 		func(addr sdk.ConsAddress, info slashingtypes.ValidatorSigningInfo) (stop bool) {
 			info.StartHeight = 0
 			app.SlashingKeeper.SetValidatorSigningInfo(ctx, addr, info)
+			report.HeightResets.SigningInfos++
 			return false
 		},
 	)
+
+	return nil
 }
@@ -0,0 +1,19 @@
+package gaia
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// BeginBlockForks runs the BeginForkLogic of the forks scheduled at the
+// current block height on the current chain. As a block height is only
+// executed once, each fork runs exactly once.
+func BeginBlockForks(ctx sdk.Context, app *GaiaApp) {
+	for _, fork := range Forks {
+		if !fork.ShouldRun(ctx) {
+			continue
+		}
+
+		ctx.Logger().Info("applying fork", "name", fork.UpgradeName, "height", fork.UpgradeHeight, "chain-id", fork.ChainID)
+		fork.BeginForkLogic(ctx, &app.AppKeepers)
+	}
+}
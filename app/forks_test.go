@@ -0,0 +1,49 @@
+package gaia_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	gaia "github.com/cosmos/gaia/v17/app"
+	gaiahelpers "github.com/cosmos/gaia/v17/app/helpers"
+	"github.com/cosmos/gaia/v17/app/keepers"
+	"github.com/cosmos/gaia/v17/app/upgrades"
+)
+
+func TestBeginBlockForks(t *testing.T) {
+	app := gaiahelpers.Setup(t)
+
+	var runs []string
+	forkLogic := func(name string) func(sdk.Context, *keepers.AppKeepers) {
+		return func(ctx sdk.Context, _ *keepers.AppKeepers) {
+			runs = append(runs, name)
+		}
+	}
+
+	forks := gaia.Forks
+	t.Cleanup(func() { gaia.Forks = forks })
+	gaia.Forks = []upgrades.Fork{
+		{UpgradeName: "fork-a", UpgradeHeight: 10, ChainID: gaiahelpers.SimAppChainID, BeginForkLogic: forkLogic("fork-a")},
+		{UpgradeName: "fork-b", UpgradeHeight: 20, ChainID: gaiahelpers.SimAppChainID, BeginForkLogic: forkLogic("fork-b")},
+		{UpgradeName: "fork-other", UpgradeHeight: 10, ChainID: "other-1", BeginForkLogic: forkLogic("fork-other")},
+	}
+	require.NoError(t, upgrades.ValidateForks(gaia.Forks))
+
+	for height := int64(9); height <= 21; height++ {
+		ctx := app.NewUncachedContext(false, tmproto.Header{Height: height, ChainID: gaiahelpers.SimAppChainID})
+		gaia.BeginBlockForks(ctx, app)
+	}
+	require.Equal(t, []string{"fork-a", "fork-b"}, runs)
+
+	// the fork runs as part of the app's BeginBlocker
+	runs = nil
+	header := tmproto.Header{Height: 10, ChainID: gaiahelpers.SimAppChainID}
+	app.BeginBlocker(app.NewUncachedContext(false, header), abci.RequestBeginBlock{Header: header})
+	require.Equal(t, []string{"fork-a"}, runs)
+}
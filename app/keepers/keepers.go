@@ -78,7 +78,20 @@ import (
 	upgradekeeper "github.com/cosmos/cosmos-sdk/x/upgrade/keeper"
 	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 
+	circuitbreakerkeeper "github.com/cosmos/gaia/v17/x/circuitbreaker/keeper"
+	circuitbreakertypes "github.com/cosmos/gaia/v17/x/circuitbreaker/types"
+	escrowkeeper "github.com/cosmos/gaia/v17/x/escrow/keeper"
 	"github.com/cosmos/gaia/v17/x/globalfee"
+	"github.com/cosmos/gaia/v17/x/icq"
+	icqkeeper "github.com/cosmos/gaia/v17/x/icq/keeper"
+	icqtypes "github.com/cosmos/gaia/v17/x/icq/types"
+	"github.com/cosmos/gaia/v17/x/pfmconfig"
+	pfmconfigtypes "github.com/cosmos/gaia/v17/x/pfmconfig/types"
+	"github.com/cosmos/gaia/v17/x/rewarddenoms"
+	rewarddenomskeeper "github.com/cosmos/gaia/v17/x/rewarddenoms/keeper"
+	rewarddenomstypes "github.com/cosmos/gaia/v17/x/rewarddenoms/types"
+	txlimitkeeper "github.com/cosmos/gaia/v17/x/txlimit/keeper"
+	txlimittypes "github.com/cosmos/gaia/v17/x/txlimit/types"
 )
 
 type AppKeepers struct {
@@ -103,6 +116,11 @@ type AppKeepers struct {
 	IBCKeeper             *ibckeeper.Keeper
 	ICAHostKeeper         icahostkeeper.Keeper
 	ICAControllerKeeper   icacontrollerkeeper.Keeper
+	ICQKeeper             icqkeeper.Keeper
+	EscrowKeeper          escrowkeeper.Keeper
+	RewardDenomsKeeper    rewarddenomskeeper.Keeper
+	CircuitBreakerKeeper  circuitbreakerkeeper.Keeper
+	TxLimitKeeper         txlimitkeeper.Keeper
 	EvidenceKeeper        evidencekeeper.Keeper
 	TransferKeeper        ibctransferkeeper.Keeper
 	FeeGrantKeeper        feegrantkeeper.Keeper
@@ -128,6 +146,7 @@ type AppKeepers struct {
 	ScopedTransferKeeper      capabilitykeeper.ScopedKeeper
 	ScopedICAHostKeeper       capabilitykeeper.ScopedKeeper
 	ScopedICAControllerKeeper capabilitykeeper.ScopedKeeper
+	ScopedICQKeeper           capabilitykeeper.ScopedKeeper
 	ScopedICSproviderkeeper   capabilitykeeper.ScopedKeeper
 }
 
@@ -186,6 +205,7 @@ func NewAppKeeper(
 	appKeepers.ScopedICAHostKeeper = appKeepers.CapabilityKeeper.ScopeToModule(icahosttypes.SubModuleName)
 	appKeepers.ScopedICAControllerKeeper = appKeepers.CapabilityKeeper.ScopeToModule(icacontrollertypes.SubModuleName)
 	appKeepers.ScopedTransferKeeper = appKeepers.CapabilityKeeper.ScopeToModule(ibctransfertypes.ModuleName)
+	appKeepers.ScopedICQKeeper = appKeepers.CapabilityKeeper.ScopeToModule(icqtypes.ModuleName)
 	appKeepers.ScopedICSproviderkeeper = appKeepers.CapabilityKeeper.ScopeToModule(providertypes.ModuleName)
 
 	// Applications that wish to enforce statically created ScopedKeepers should call `Seal` after creating
@@ -374,7 +394,7 @@ func NewAppKeeper(
 		appCodec,
 		appKeepers.keys[icahosttypes.StoreKey],
 		appKeepers.GetSubspace(icahosttypes.SubModuleName),
-		appKeepers.IBCKeeper.ChannelKeeper, // ICS4Wrapper
+		appKeepers.IBCFeeKeeper, // ICS4Wrapper: IBC Fee middleware
 		appKeepers.IBCKeeper.ChannelKeeper,
 		&appKeepers.IBCKeeper.PortKeeper,
 		appKeepers.AccountKeeper,
@@ -382,6 +402,15 @@ func NewAppKeeper(
 		bApp.MsgServiceRouter(),
 	)
 
+	// ICQ Host keeper
+	appKeepers.ICQKeeper = icqkeeper.NewKeeper(
+		appKeepers.keys[icqtypes.StoreKey],
+		appKeepers.GetSubspace(icqtypes.ModuleName),
+		&appKeepers.IBCKeeper.PortKeeper,
+		appKeepers.ScopedICQKeeper,
+		bApp.GRPCQueryRouter(),
+	)
+
 	govAuthority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
 
 	// Create RateLimit keeper
@@ -400,7 +429,7 @@ func NewAppKeeper(
 		appCodec,
 		appKeepers.keys[icacontrollertypes.StoreKey],
 		appKeepers.GetSubspace(icacontrollertypes.SubModuleName),
-		appKeepers.IBCKeeper.ChannelKeeper, // ICS4Wrapper
+		appKeepers.IBCFeeKeeper, // ICS4Wrapper: IBC Fee middleware
 		appKeepers.IBCKeeper.ChannelKeeper,
 		&appKeepers.IBCKeeper.PortKeeper,
 		appKeepers.ScopedICAControllerKeeper,
@@ -434,6 +463,36 @@ func NewAppKeeper(
 	// Must be called on PFMRouter AFTER TransferKeeper initialized
 	appKeepers.PFMRouterKeeper.SetTransferKeeper(appKeepers.TransferKeeper)
 
+	appKeepers.EscrowKeeper = escrowkeeper.NewKeeper(
+		appKeepers.BankKeeper,
+		appKeepers.TransferKeeper,
+		appKeepers.IBCKeeper.ChannelKeeper,
+		govAuthority,
+	)
+
+	appKeepers.RewardDenomsKeeper = rewarddenomskeeper.NewKeeper(
+		appCodec,
+		appKeepers.keys[rewarddenomstypes.StoreKey],
+		appKeepers.ProviderKeeper,
+		appKeepers.IBCKeeper.ChannelKeeper,
+		appKeepers.IBCKeeper.ConnectionKeeper,
+		govAuthority,
+	)
+
+	appKeepers.CircuitBreakerKeeper = circuitbreakerkeeper.NewKeeper(
+		appCodec,
+		appKeepers.keys[circuitbreakertypes.StoreKey],
+		bApp.MsgServiceRouter(),
+		govAuthority,
+	)
+
+	appKeepers.TxLimitKeeper = txlimitkeeper.NewKeeper(
+		appCodec,
+		appKeepers.keys[txlimittypes.StoreKey],
+		appKeepers.tkeys[txlimittypes.TStoreKey],
+		govAuthority,
+	)
+
 	// Middleware Stacks
 	appKeepers.ICAModule = ica.NewAppModule(&appKeepers.ICAControllerKeeper, &appKeepers.ICAHostKeeper)
 	appKeepers.TransferModule = transfer.NewAppModule(appKeepers.TransferKeeper)
@@ -445,36 +504,55 @@ func NewAppKeeper(
 	// - ibcfee
 	// - ratelimit
 	// - pfm
+	// - rewarddenoms
 	// - provider
 	// - transfer
 	//
 	// This is how transfer stack will work in the end:
-	// * RecvPacket -> IBC core -> Fee -> RateLimit -> PFM -> Provider -> Transfer (AddRoute)
+	// * RecvPacket -> IBC core -> Fee -> RateLimit -> PFM -> RewardDenoms -> Provider -> Transfer (AddRoute)
 	// * SendPacket -> Transfer -> Provider -> PFM -> RateLimit -> Fee -> IBC core (ICS4Wrapper)
 
 	var transferStack porttypes.IBCModule
 	transferStack = transfer.NewIBCModule(appKeepers.TransferKeeper)
 	transferStack = icsprovider.NewIBCMiddleware(transferStack, appKeepers.ProviderKeeper)
-	transferStack = pfmrouter.NewIBCMiddleware(
+	// consumer reward denoms allowed by a rule are registered once the channel is open
+	transferStack = rewarddenoms.NewIBCMiddleware(transferStack, appKeepers.RewardDenomsKeeper)
+	// PFM retries and timeouts are read from the pfmconfig params on every packet
+	transferStack = pfmconfig.NewIBCMiddleware(
 		transferStack,
 		appKeepers.PFMRouterKeeper,
-		0, // retries on timeout
-		pfmrouterkeeper.DefaultForwardTransferPacketTimeoutTimestamp,
-		pfmrouterkeeper.DefaultRefundTransferPacketTimeoutTimestamp,
+		appKeepers.GetSubspace(pfmconfig.ModuleName),
 	)
 	transferStack = ratelimit.NewIBCMiddleware(appKeepers.RatelimitKeeper, transferStack)
 	transferStack = ibcfee.NewIBCMiddleware(transferStack, appKeepers.IBCFeeKeeper)
 
-	// Create ICAHost Stack
-	var icaHostStack porttypes.IBCModule = icahost.NewIBCModule(appKeepers.ICAHostKeeper)
+	// Create ICAHost Stack (from bottom to top of stack)
+	// - core IBC
+	// - ibcfee
+	// - ICA host
+	//
+	// Fee incentivization is negotiated per channel during the handshake,
+	// so channels opened without the fee version are passed through as is.
+	var icaHostStack porttypes.IBCModule
+	icaHostStack = icahost.NewIBCModule(appKeepers.ICAHostKeeper)
+	icaHostStack = ibcfee.NewIBCMiddleware(icaHostStack, appKeepers.IBCFeeKeeper)
+
+	// Create Interchain Accounts Controller Stack (from bottom to top of stack)
+	// - core IBC
+	// - ibcfee
+	// - ICA controller
+	var icaControllerStack porttypes.IBCModule
+	icaControllerStack = icacontroller.NewIBCMiddleware(nil, appKeepers.ICAControllerKeeper)
+	icaControllerStack = ibcfee.NewIBCMiddleware(icaControllerStack, appKeepers.IBCFeeKeeper)
 
-	// Create Interchain Accounts Controller Stack
-	var icaControllerStack porttypes.IBCModule = icacontroller.NewIBCMiddleware(nil, appKeepers.ICAControllerKeeper)
+	// Create Interchain Query Host Stack
+	var icqHostStack porttypes.IBCModule = icq.NewIBCModule(appKeepers.ICQKeeper)
 
 	// Create IBC Router & seal
 	ibcRouter := porttypes.NewRouter().
 		AddRoute(icahosttypes.SubModuleName, icaHostStack).
 		AddRoute(icacontrollertypes.SubModuleName, icaControllerStack).
+		AddRoute(icqtypes.ModuleName, icqHostStack).
 		AddRoute(ibctransfertypes.ModuleName, transferStack).
 		AddRoute(providertypes.ModuleName, appKeepers.ProviderModule)
 
@@ -509,7 +587,9 @@ func initParamsKeeper(appCodec codec.BinaryCodec, legacyAmino *codec.LegacyAmino
 	paramsKeeper.Subspace(ibcexported.ModuleName)
 	paramsKeeper.Subspace(icahosttypes.SubModuleName)
 	paramsKeeper.Subspace(icacontrollertypes.SubModuleName)
+	paramsKeeper.Subspace(icqtypes.ModuleName).WithKeyTable(icqtypes.ParamKeyTable())
 	paramsKeeper.Subspace(pfmroutertypes.ModuleName).WithKeyTable(pfmroutertypes.ParamKeyTable())
+	paramsKeeper.Subspace(pfmconfig.ModuleName).WithKeyTable(pfmconfigtypes.ParamKeyTable())
 	paramsKeeper.Subspace(ratelimittypes.ModuleName)
 	paramsKeeper.Subspace(globalfee.ModuleName)
 	paramsKeeper.Subspace(providertypes.ModuleName)
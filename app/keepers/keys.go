@@ -28,6 +28,11 @@ import (
 	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+
+	circuitbreakertypes "github.com/cosmos/gaia/v17/x/circuitbreaker/types"
+	icqtypes "github.com/cosmos/gaia/v17/x/icq/types"
+	rewarddenomstypes "github.com/cosmos/gaia/v17/x/rewarddenoms/types"
+	txlimittypes "github.com/cosmos/gaia/v17/x/txlimit/types"
 )
 
 func (appKeepers *AppKeepers) GenerateKeys() {
@@ -57,10 +62,14 @@ func (appKeepers *AppKeepers) GenerateKeys() {
 		ratelimittypes.StoreKey,
 		providertypes.StoreKey,
 		consensusparamtypes.StoreKey,
+		icqtypes.StoreKey,
+		rewarddenomstypes.StoreKey,
+		circuitbreakertypes.StoreKey,
+		txlimittypes.StoreKey,
 	)
 
 	// Define transient store keys
-	appKeepers.tkeys = sdk.NewTransientStoreKeys(paramstypes.TStoreKey)
+	appKeepers.tkeys = sdk.NewTransientStoreKeys(paramstypes.TStoreKey, txlimittypes.TStoreKey)
 
 	// MemKeys are for information that is stored only in RAM.
 	appKeepers.memKeys = sdk.NewMemoryStoreKeys(capabilitytypes.MemStoreKey)
@@ -61,9 +61,15 @@ import (
 	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 
 	gaiaappparams "github.com/cosmos/gaia/v17/app/params"
+	"github.com/cosmos/gaia/v17/x/circuitbreaker"
+	"github.com/cosmos/gaia/v17/x/escrow"
 	"github.com/cosmos/gaia/v17/x/globalfee"
+	"github.com/cosmos/gaia/v17/x/icq"
 	"github.com/cosmos/gaia/v17/x/metaprotocols"
 	metaprotocolstypes "github.com/cosmos/gaia/v17/x/metaprotocols/types"
+	"github.com/cosmos/gaia/v17/x/pfmconfig"
+	"github.com/cosmos/gaia/v17/x/rewarddenoms"
+	"github.com/cosmos/gaia/v17/x/txlimit"
 )
 
 var maccPerms = map[string][]string{
@@ -80,6 +86,16 @@ var maccPerms = map[string][]string{
 	providertypes.ConsumerRewardsPool: nil,
 }
 
+// GetMaccPerms returns a copy of the module account permissions.
+func GetMaccPerms() map[string][]string {
+	dupMaccPerms := make(map[string][]string, len(maccPerms))
+	for k, v := range maccPerms {
+		dupMaccPerms[k] = v
+	}
+
+	return dupMaccPerms
+}
+
 // ModuleBasics defines the module BasicManager is in charge of setting up basic,
 // non-dependant module elements, such as codec registration
 // and genesis verification.
@@ -116,8 +132,14 @@ var ModuleBasics = module.NewBasicManager(
 	transfer.AppModuleBasic{},
 	vesting.AppModuleBasic{},
 	pfmrouter.AppModuleBasic{},
+	pfmconfig.AppModuleBasic{},
 	ratelimit.AppModuleBasic{},
+	escrow.AppModuleBasic{},
+	rewarddenoms.AppModuleBasic{},
+	circuitbreaker.AppModuleBasic{},
+	txlimit.AppModuleBasic{},
 	ica.AppModuleBasic{},
+	icq.AppModuleBasic{},
 	globalfee.AppModule{},
 	icsprovider.AppModuleBasic{},
 	consensus.AppModuleBasic{},
@@ -159,8 +181,14 @@ func appModules(
 		ibcfee.NewAppModule(app.IBCFeeKeeper),
 		app.TransferModule,
 		app.ICAModule,
+		icq.NewAppModule(app.ICQKeeper),
 		app.PFMRouterModule,
+		pfmconfig.NewAppModule(app.GetSubspace(pfmconfig.ModuleName)),
 		app.RateLimitModule,
+		escrow.NewAppModule(app.EscrowKeeper),
+		rewarddenoms.NewAppModule(app.RewardDenomsKeeper),
+		circuitbreaker.NewAppModule(app.CircuitBreakerKeeper),
+		txlimit.NewAppModule(app.TxLimitKeeper),
 
 		app.ProviderModule,
 		metaprotocols.NewAppModule(),
@@ -224,8 +252,14 @@ func orderBeginBlockers() []string {
 		ibcexported.ModuleName,
 		ibctransfertypes.ModuleName,
 		icatypes.ModuleName,
+		icq.ModuleName,
 		pfmroutertypes.ModuleName,
+		pfmconfig.ModuleName,
 		ratelimittypes.ModuleName,
+		escrow.ModuleName,
+		rewarddenoms.ModuleName,
+		circuitbreaker.ModuleName,
+		txlimit.ModuleName,
 		ibcfeetypes.ModuleName,
 		genutiltypes.ModuleName,
 		authz.ModuleName,
@@ -255,8 +289,14 @@ func orderEndBlockers() []string {
 		ibcexported.ModuleName,
 		ibctransfertypes.ModuleName,
 		icatypes.ModuleName,
+		icq.ModuleName,
 		pfmroutertypes.ModuleName,
+		pfmconfig.ModuleName,
 		ratelimittypes.ModuleName,
+		escrow.ModuleName,
+		rewarddenoms.ModuleName,
+		circuitbreaker.ModuleName,
+		txlimit.ModuleName,
 		capabilitytypes.ModuleName,
 		ibcfeetypes.ModuleName,
 		authtypes.ModuleName,
@@ -301,12 +341,18 @@ func orderInitBlockers() []string {
 		ibctransfertypes.ModuleName,
 		ibcexported.ModuleName,
 		icatypes.ModuleName,
+		icq.ModuleName,
 		ibcfeetypes.ModuleName,
 		evidencetypes.ModuleName,
 		authz.ModuleName,
 		feegrant.ModuleName,
 		pfmroutertypes.ModuleName,
+		pfmconfig.ModuleName,
 		ratelimittypes.ModuleName,
+		escrow.ModuleName,
+		rewarddenoms.ModuleName,
+		circuitbreaker.ModuleName,
+		txlimit.ModuleName,
 		paramstypes.ModuleName,
 		upgradetypes.ModuleName,
 		vestingtypes.ModuleName,
@@ -0,0 +1,162 @@
+package gaia
+
+import (
+	"fmt"
+	"time"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	tmtypes "github.com/cometbft/cometbft/types"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	evidencetypes "github.com/cosmos/cosmos-sdk/x/evidence/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// InPlaceTestnetConfig holds the changes made to the state of a node to turn
+// it into a single validator testnet.
+type InPlaceTestnetConfig struct {
+	// ChainID is the chain ID of the testnet.
+	ChainID string
+	// Operator is the validator that is run by the local node.
+	Operator sdk.ValAddress
+	// ConsensusPubKey is the consensus key of the local node.
+	ConsensusPubKey cryptotypes.PubKey
+	// VotingPeriod is the voting period of governance proposals.
+	VotingPeriod time.Duration
+	// AccountsToFund are the accounts credited with FundAmount.
+	AccountsToFund []sdk.AccAddress
+	FundAmount     sdk.Coins
+}
+
+// InitForInPlaceTestnet modifies the latest state of the app so that the
+// local node can run it as a testnet on its own:
+//   - the operator's validator gets the local consensus key, is unjailed and
+//     gets more than 2/3 of the voting power through a minted self-delegation
+//   - the other bonded validators are jailed for good, so that they leave the
+//     validator set at the next block
+//   - the gov voting period is shortened and the test accounts are funded
+//
+// The changes are written to the working state of the app and committed with
+// the next block, so the app must be started right away. It returns the
+// validator set the consensus engine must use for the next block.
+func (app *GaiaApp) InitForInPlaceTestnet(cfg InPlaceTestnetConfig) (*tmtypes.ValidatorSet, error) {
+	ctx := app.NewUncachedContext(false, tmproto.Header{ChainID: cfg.ChainID, Height: app.LastBlockHeight() + 1})
+	powerReduction := app.StakingKeeper.PowerReduction(ctx)
+
+	validator, found := app.StakingKeeper.GetValidator(ctx, cfg.Operator)
+	if !found {
+		return nil, fmt.Errorf("validator %s not found", cfg.Operator)
+	}
+	oldConsAddr, err := validator.GetConsAddr()
+	if err != nil {
+		return nil, err
+	}
+
+	// jail the other bonded validators, they stay in the consensus validator
+	// set until their removal is applied
+	var (
+		tmValidators []*tmtypes.Validator
+		othersPower  int64
+	)
+	for _, val := range app.StakingKeeper.GetLastValidators(ctx) {
+		if val.GetOperator().Equals(cfg.Operator) {
+			continue
+		}
+
+		power := app.StakingKeeper.GetLastValidatorPower(ctx, val.GetOperator())
+		pubKey, err := val.ConsPubKey()
+		if err != nil {
+			return nil, err
+		}
+		tmPubKey, err := cryptocodec.ToTmPubKeyInterface(pubKey)
+		if err != nil {
+			return nil, err
+		}
+		tmValidators = append(tmValidators, tmtypes.NewValidator(tmPubKey, power))
+		othersPower += power
+
+		consAddr, err := val.GetConsAddr()
+		if err != nil {
+			return nil, err
+		}
+		if !val.IsJailed() {
+			app.StakingKeeper.Jail(ctx, consAddr)
+		}
+		if _, found := app.SlashingKeeper.GetValidatorSigningInfo(ctx, consAddr); found {
+			app.SlashingKeeper.JailUntil(ctx, consAddr, evidencetypes.DoubleSignJailEndTime)
+		}
+	}
+
+	// replace the consensus key of the local validator
+	if validator.IsJailed() {
+		app.StakingKeeper.Unjail(ctx, oldConsAddr)
+	}
+	newConsAddr := sdk.ConsAddress(cfg.ConsensusPubKey.Address())
+	validator, _ = app.StakingKeeper.GetValidator(ctx, cfg.Operator)
+	validator.ConsensusPubkey, err = codectypes.NewAnyWithValue(cfg.ConsensusPubKey)
+	if err != nil {
+		return nil, err
+	}
+	app.StakingKeeper.SetValidator(ctx, validator)
+	ctx.KVStore(app.GetKey(stakingtypes.StoreKey)).Delete(stakingtypes.GetValidatorByConsAddrKey(oldConsAddr))
+	if err := app.StakingKeeper.SetValidatorByConsAddr(ctx, validator); err != nil {
+		return nil, err
+	}
+	if err := app.SlashingKeeper.AddPubkey(ctx, cfg.ConsensusPubKey); err != nil {
+		return nil, err
+	}
+	app.SlashingKeeper.SetValidatorSigningInfo(ctx, newConsAddr, slashingtypes.NewValidatorSigningInfo(
+		newConsAddr, ctx.BlockHeight(), 0, time.Unix(0, 0), false, 0,
+	))
+
+	// self-delegate minted tokens to get more than 2/3 of the voting power
+	if power, target := validator.ConsensusPower(powerReduction), 2*othersPower+1; power < target {
+		tokens := sdk.TokensFromConsensusPower(target-power, powerReduction)
+		coins := sdk.NewCoins(sdk.NewCoin(app.StakingKeeper.BondDenom(ctx), tokens))
+		if err := app.mint(ctx, sdk.AccAddress(cfg.Operator), coins); err != nil {
+			return nil, err
+		}
+		if _, err := app.StakingKeeper.Delegate(ctx, sdk.AccAddress(cfg.Operator), tokens, stakingtypes.Unbonded, validator, true); err != nil {
+			return nil, err
+		}
+		validator, _ = app.StakingKeeper.GetValidator(ctx, cfg.Operator)
+	}
+	tmPubKey, err := cryptocodec.ToTmPubKeyInterface(cfg.ConsensusPubKey)
+	if err != nil {
+		return nil, err
+	}
+	tmValidators = append(tmValidators, tmtypes.NewValidator(tmPubKey, validator.ConsensusPower(powerReduction)))
+
+	// governance
+	govParams := app.GovKeeper.GetParams(ctx)
+	govParams.VotingPeriod = &cfg.VotingPeriod
+	if err := app.GovKeeper.SetParams(ctx, govParams); err != nil {
+		return nil, err
+	}
+
+	// test accounts
+	for _, addr := range cfg.AccountsToFund {
+		if err := app.mint(ctx, addr, cfg.FundAmount); err != nil {
+			return nil, err
+		}
+	}
+
+	return tmtypes.NewValidatorSet(tmValidators), nil
+}
+
+// mint mints the coins to the account.
+func (app *GaiaApp) mint(ctx sdk.Context, addr sdk.AccAddress, coins sdk.Coins) error {
+	if coins.Empty() {
+		return nil
+	}
+	if err := app.MintKeeper.MintCoins(ctx, coins); err != nil {
+		return err
+	}
+
+	return app.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, addr, coins)
+}
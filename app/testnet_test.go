@@ -0,0 +1,82 @@
+package gaia_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	gaia "github.com/cosmos/gaia/v17/app"
+	gaiahelpers "github.com/cosmos/gaia/v17/app/helpers"
+	"github.com/cosmos/gaia/v17/app/upgrades"
+)
+
+func TestGaiaApp_InitForInPlaceTestnet(t *testing.T) {
+	app := gaiahelpers.Setup(t)
+	ctx := app.NewContext(false, tmproto.Header{Height: app.LastBlockHeight() + 1})
+	operator := app.StakingKeeper.GetLastValidators(ctx)[0].GetOperator()
+
+	// a second validator joins the validator set
+	otherOperator := sdk.ValAddress("other-validator")
+	selfDelegation := sdk.NewCoin(sdk.DefaultBondDenom, sdk.TokensFromConsensusPower(1, sdk.DefaultPowerReduction))
+	require.NoError(t, app.MintKeeper.MintCoins(ctx, sdk.NewCoins(selfDelegation)))
+	require.NoError(t, app.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, sdk.AccAddress(otherOperator), sdk.NewCoins(selfDelegation)))
+	msg, err := stakingtypes.NewMsgCreateValidator(
+		otherOperator, ed25519.GenPrivKey().PubKey(), selfDelegation,
+		stakingtypes.NewDescription("other", "", "", "", ""),
+		stakingtypes.NewCommissionRates(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec()),
+	)
+	require.NoError(t, err)
+	_, err = stakingkeeper.NewMsgServerImpl(app.StakingKeeper).CreateValidator(ctx, msg)
+	require.NoError(t, err)
+	app.EndBlock(abci.RequestEndBlock{Height: ctx.BlockHeight()})
+	app.Commit()
+	require.Len(t, app.StakingKeeper.GetLastValidators(app.NewContext(true, tmproto.Header{})), 2)
+
+	pubKey := ed25519.GenPrivKey().PubKey()
+	funded := sdk.AccAddress("funded-account")
+	valSet, err := app.InitForInPlaceTestnet(gaia.InPlaceTestnetConfig{
+		ChainID:         gaiahelpers.SimAppChainID,
+		Operator:        operator,
+		ConsensusPubKey: pubKey,
+		VotingPeriod:    time.Minute,
+		AccountsToFund:  []sdk.AccAddress{funded},
+		FundAmount:      sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000)),
+	})
+	require.NoError(t, err)
+
+	// the local validator holds more than 2/3 of the power of the next block
+	require.Equal(t, 2, valSet.Size())
+	_, local := valSet.GetByAddress(pubKey.Address())
+	require.NotNil(t, local)
+	require.Greater(t, 3*local.VotingPower, 2*valSet.TotalVotingPower())
+
+	// the other validator leaves the validator set at the next block
+	app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: app.LastBlockHeight() + 1}})
+	res := app.EndBlock(abci.RequestEndBlock{Height: app.LastBlockHeight() + 1})
+	app.Commit()
+	require.Len(t, res.ValidatorUpdates, 2)
+	require.Contains(t, res.ValidatorUpdates, abci.Ed25519ValidatorUpdate(pubKey.Bytes(), local.VotingPower))
+
+	ctx = app.NewContext(true, tmproto.Header{})
+	other, found := app.StakingKeeper.GetValidator(ctx, otherOperator)
+	require.True(t, found)
+	require.True(t, other.IsJailed())
+	require.Equal(t, stakingtypes.Unbonding, other.GetStatus())
+
+	validator, found := app.StakingKeeper.GetValidatorByConsAddr(ctx, sdk.ConsAddress(pubKey.Address()))
+	require.True(t, found)
+	require.Equal(t, operator, validator.GetOperator())
+	require.Equal(t, time.Minute, *app.GovKeeper.GetParams(ctx).VotingPeriod)
+	require.Equal(t, "1000"+sdk.DefaultBondDenom, app.BankKeeper.GetAllBalances(ctx, funded).String())
+	require.NoError(t, upgrades.CheckInvariants(ctx, &app.AppKeepers))
+}
@@ -0,0 +1,307 @@
+package gaia
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+
+	gogoproto "github.com/cosmos/gogoproto/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+
+	"github.com/cosmos/gaia/v17/app/upgrades"
+)
+
+// UpgradeDryRunResult summarizes the state changes made by an upgrade handler.
+type UpgradeDryRunResult struct {
+	Upgrade        string                `json:"upgrade"`
+	Height         int64                 `json:"height"`
+	ModuleVersions []ModuleVersionChange `json:"module_versions"`
+	Supply         []SupplyChange        `json:"supply"`
+	Balances       []BalanceChange       `json:"balances"`
+	Params         []ParamsChange        `json:"params"`
+}
+
+// ModuleVersionChange is a module whose consensus version was changed,
+// added or removed by the upgrade.
+type ModuleVersionChange struct {
+	Module string `json:"module"`
+	From   uint64 `json:"from"`
+	To     uint64 `json:"to"`
+}
+
+// SupplyChange is a change of the total supply of a denom.
+type SupplyChange struct {
+	Denom  string      `json:"denom"`
+	Before sdkmath.Int `json:"before"`
+	After  sdkmath.Int `json:"after"`
+}
+
+// BalanceChange is a change of the balance of an account for a denom.
+type BalanceChange struct {
+	Address string      `json:"address"`
+	Denom   string      `json:"denom"`
+	Before  sdkmath.Int `json:"before"`
+	After   sdkmath.Int `json:"after"`
+}
+
+// ParamsChange is a change of the params returned by a module's params query.
+type ParamsChange struct {
+	Query  string          `json:"query"`
+	Before json.RawMessage `json:"before"`
+	After  json.RawMessage `json:"after"`
+}
+
+// GetUpgrade returns the upgrade registered under the given name.
+func GetUpgrade(name string) (upgrades.Upgrade, error) {
+	names := make([]string, 0, len(Upgrades))
+	for _, upgrade := range Upgrades {
+		if upgrade.UpgradeName == name {
+			return upgrade, nil
+		}
+		names = append(names, upgrade.UpgradeName)
+	}
+
+	return upgrades.Upgrade{}, fmt.Errorf("unknown upgrade %s, available upgrades: %v", name, names)
+}
+
+// DryRunUpgrade applies the named upgrade, as the x/upgrade BeginBlocker does
+// at the upgrade height, on a branch of the given context and returns the
+// resulting state changes. The state of ctx is left untouched. The store
+// upgrades of the upgrade must already be applied to the loaded stores.
+func (app *GaiaApp) DryRunUpgrade(ctx sdk.Context, name string) (result UpgradeDryRunResult, err error) {
+	if _, err := GetUpgrade(name); err != nil {
+		return result, err
+	}
+	if !app.UpgradeKeeper.HasHandler(name) {
+		return result, fmt.Errorf("no upgrade handler registered for %s", name)
+	}
+
+	cacheCtx, _ := ctx.CacheContext()
+	plan := upgradetypes.Plan{Name: name, Height: ctx.BlockHeight()}
+
+	// ApplyUpgrade panics if the upgrade handler fails
+	func() {
+		defer func() {
+			if r := recover(); r != nil {
+				err = fmt.Errorf("upgrade %s failed: %v", name, r)
+			}
+		}()
+		app.UpgradeKeeper.ApplyUpgrade(cacheCtx, plan)
+	}()
+	if err != nil {
+		return result, err
+	}
+
+	params, err := app.diffParams(ctx, cacheCtx)
+	if err != nil {
+		return result, err
+	}
+
+	return UpgradeDryRunResult{
+		Upgrade:        name,
+		Height:         ctx.BlockHeight(),
+		ModuleVersions: diffModuleVersions(app.UpgradeKeeper.GetModuleVersionMap(ctx), app.UpgradeKeeper.GetModuleVersionMap(cacheCtx)),
+		Supply:         diffSupply(upgrades.TotalSupply(ctx, &app.AppKeepers), upgrades.TotalSupply(cacheCtx, &app.AppKeepers)),
+		Balances:       app.diffBalances(ctx, cacheCtx),
+		Params:         params,
+	}, nil
+}
+
+func diffModuleVersions(before, after module.VersionMap) []ModuleVersionChange {
+	modules := make(map[string]struct{}, len(after))
+	for name := range before {
+		modules[name] = struct{}{}
+	}
+	for name := range after {
+		modules[name] = struct{}{}
+	}
+
+	var changes []ModuleVersionChange
+	for name := range modules {
+		if before[name] != after[name] {
+			changes = append(changes, ModuleVersionChange{Module: name, From: before[name], To: after[name]})
+		}
+	}
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].Module < changes[j].Module
+	})
+
+	return changes
+}
+
+func diffSupply(before, after sdk.Coins) []SupplyChange {
+	var changes []SupplyChange
+	// coins are sorted by denom and hold no zero amounts
+	for _, coin := range before.Add(after...) {
+		if from, to := before.AmountOf(coin.Denom), after.AmountOf(coin.Denom); !from.Equal(to) {
+			changes = append(changes, SupplyChange{Denom: coin.Denom, Before: from, After: to})
+		}
+	}
+
+	return changes
+}
+
+// diffBalances compares the balances of both contexts by walking the bank
+// balances store of each in key order, so that the whole state is never
+// held in memory.
+func (app *GaiaApp) diffBalances(before, after sdk.Context) []BalanceChange {
+	storeKey := app.GetKey(banktypes.StoreKey)
+	beforeIt := sdk.KVStorePrefixIterator(before.KVStore(storeKey), banktypes.BalancesPrefix)
+	defer beforeIt.Close()
+	afterIt := sdk.KVStorePrefixIterator(after.KVStore(storeKey), banktypes.BalancesPrefix)
+	defer afterIt.Close()
+
+	var changes []BalanceChange
+	for beforeIt.Valid() || afterIt.Valid() {
+		var key []byte
+		switch {
+		case !afterIt.Valid():
+			key = beforeIt.Key()
+		case !beforeIt.Valid():
+			key = afterIt.Key()
+		default:
+			key = beforeIt.Key()
+			if bytes.Compare(afterIt.Key(), key) < 0 {
+				key = afterIt.Key()
+			}
+		}
+		key = bytes.Clone(key)
+
+		var from, to []byte
+		if beforeIt.Valid() && bytes.Equal(beforeIt.Key(), key) {
+			from = beforeIt.Value()
+			beforeIt.Next()
+		}
+		if afterIt.Valid() && bytes.Equal(afterIt.Key(), key) {
+			to = afterIt.Value()
+			afterIt.Next()
+		}
+		if bytes.Equal(from, to) {
+			continue
+		}
+
+		addr, denom, err := banktypes.AddressAndDenomFromBalancesStore(key[len(banktypes.BalancesPrefix):])
+		if err != nil {
+			panic(err)
+		}
+		changes = append(changes, BalanceChange{
+			Address: addr.String(),
+			Denom:   denom,
+			Before:  app.unmarshalBalance(from, denom),
+			After:   app.unmarshalBalance(to, denom),
+		})
+	}
+
+	return changes
+}
+
+func (app *GaiaApp) unmarshalBalance(bz []byte, denom string) sdkmath.Int {
+	if bz == nil {
+		return sdkmath.ZeroInt()
+	}
+
+	balance, err := bankkeeper.UnmarshalBalanceCompat(app.appCodec, bz, denom)
+	if err != nil {
+		panic(err)
+	}
+
+	return balance.Amount
+}
+
+// diffParams runs every params query without arguments registered on the
+// app, e.g. /cosmos.staking.v1beta1.Query/Params, against both contexts and
+// returns the queries whose response differs.
+func (app *GaiaApp) diffParams(before, after sdk.Context) ([]ParamsChange, error) {
+	var changes []ParamsChange
+	for _, query := range app.paramsQueries() {
+		from, err := app.queryParams(before, query)
+		if err != nil {
+			return nil, err
+		}
+		to, err := app.queryParams(after, query)
+		if err != nil {
+			return nil, err
+		}
+
+		if !bytes.Equal(from, to) {
+			changes = append(changes, ParamsChange{Query: query.path, Before: from, After: to})
+		}
+	}
+
+	return changes, nil
+}
+
+type paramsQuery struct {
+	path         string
+	responseType string
+}
+
+// paramsQueries returns the registered params queries that take no arguments,
+// sorted by path.
+func (app *GaiaApp) paramsQueries() []paramsQuery {
+	var queries []paramsQuery
+	gogoproto.HybridResolver.RangeFiles(func(fd protoreflect.FileDescriptor) bool {
+		services := fd.Services()
+		for i := 0; i < services.Len(); i++ {
+			service := services.Get(i)
+			if service.Name() != "Query" {
+				continue
+			}
+
+			methods := service.Methods()
+			for j := 0; j < methods.Len(); j++ {
+				method := methods.Get(j)
+				if (method.Name() != "Params" && method.Name() != "QueryParams") || method.Input().Fields().Len() != 0 {
+					continue
+				}
+
+				path := fmt.Sprintf("/%s/%s", service.FullName(), method.Name())
+				if app.GRPCQueryRouter().Route(path) == nil {
+					continue
+				}
+				queries = append(queries, paramsQuery{path: path, responseType: string(method.Output().FullName())})
+			}
+		}
+		return true
+	})
+	sort.Slice(queries, func(i, j int) bool {
+		return queries[i].path < queries[j].path
+	})
+
+	return queries
+}
+
+// queryParams runs the params query and returns its response as JSON.
+func (app *GaiaApp) queryParams(ctx sdk.Context, query paramsQuery) (json.RawMessage, error) {
+	res, err := app.GRPCQueryRouter().Route(query.path)(ctx, abci.RequestQuery{Path: query.path})
+	if err != nil {
+		return nil, fmt.Errorf("query %s: %w", query.path, err)
+	}
+
+	typ := gogoproto.MessageType(query.responseType)
+	if typ == nil {
+		return nil, fmt.Errorf("query %s: unknown response type %s", query.path, query.responseType)
+	}
+	msg, ok := reflect.New(typ.Elem()).Interface().(codec.ProtoMarshaler)
+	if !ok {
+		return nil, fmt.Errorf("query %s: invalid response type %s", query.path, query.responseType)
+	}
+	if err := app.appCodec.Unmarshal(res.Value, msg); err != nil {
+		return nil, fmt.Errorf("query %s: %w", query.path, err)
+	}
+
+	return app.appCodec.MarshalJSON(msg)
+}
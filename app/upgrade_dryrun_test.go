@@ -0,0 +1,109 @@
+package gaia_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+
+	gaia "github.com/cosmos/gaia/v17/app"
+	gaiahelpers "github.com/cosmos/gaia/v17/app/helpers"
+	"github.com/cosmos/gaia/v17/app/keepers"
+	"github.com/cosmos/gaia/v17/app/upgrades"
+	icqtypes "github.com/cosmos/gaia/v17/x/icq/types"
+)
+
+func TestDryRunUpgrade(t *testing.T) {
+	recipient := sdk.AccAddress("dry-run-recipient___")
+	minted := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000))
+
+	registered := gaia.Upgrades
+	t.Cleanup(func() { gaia.Upgrades = registered })
+	gaia.Upgrades = append([]upgrades.Upgrade{
+		{
+			UpgradeName: "dry-run",
+			CreateUpgradeHandler: func(mm *module.Manager, _ module.Configurator, keepers *keepers.AppKeepers) upgradetypes.UpgradeHandler {
+				return func(ctx sdk.Context, _ upgradetypes.Plan, _ module.VersionMap) (module.VersionMap, error) {
+					if err := keepers.BankKeeper.MintCoins(ctx, minttypes.ModuleName, minted); err != nil {
+						return nil, err
+					}
+					if err := keepers.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, recipient, minted); err != nil {
+						return nil, err
+					}
+
+					params := keepers.StakingKeeper.GetParams(ctx)
+					params.MaxValidators++
+					if err := keepers.StakingKeeper.SetParams(ctx, params); err != nil {
+						return nil, err
+					}
+
+					return mm.GetVersionMap(), nil
+				}
+			},
+			SupplyChange: func(sdk.Context) (sdk.Coins, sdk.Coins, error) {
+				return minted, nil, nil
+			},
+		},
+		{
+			UpgradeName: "dry-run-undeclared-mint",
+			CreateUpgradeHandler: func(mm *module.Manager, _ module.Configurator, keepers *keepers.AppKeepers) upgradetypes.UpgradeHandler {
+				return func(ctx sdk.Context, _ upgradetypes.Plan, _ module.VersionMap) (module.VersionMap, error) {
+					return mm.GetVersionMap(), keepers.BankKeeper.MintCoins(ctx, minttypes.ModuleName, minted)
+				}
+			},
+		},
+		{
+			UpgradeName: "dry-run-failure",
+			CreateUpgradeHandler: func(_ *module.Manager, _ module.Configurator, _ *keepers.AppKeepers) upgradetypes.UpgradeHandler {
+				return func(_ sdk.Context, _ upgradetypes.Plan, _ module.VersionMap) (module.VersionMap, error) {
+					return nil, errors.New("migration failed")
+				}
+			},
+		},
+	}, registered...)
+
+	app := gaiahelpers.Setup(t)
+	ctx := app.NewUncachedContext(false, tmproto.Header{Height: 10, ChainID: gaiahelpers.SimAppChainID})
+	supply := app.BankKeeper.GetSupply(ctx, sdk.DefaultBondDenom)
+	// the icq module is added by the upgrade
+	ctx.KVStore(app.GetKey(upgradetypes.StoreKey)).Delete(append([]byte{upgradetypes.VersionMapByte}, icqtypes.ModuleName...))
+
+	result, err := app.DryRunUpgrade(ctx, "dry-run")
+	require.NoError(t, err)
+	require.Equal(t, "dry-run", result.Upgrade)
+	require.Equal(t, int64(10), result.Height)
+	require.Equal(t, []gaia.ModuleVersionChange{{Module: icqtypes.ModuleName, From: 0, To: 1}}, result.ModuleVersions)
+	require.Equal(t, []gaia.SupplyChange{
+		{Denom: sdk.DefaultBondDenom, Before: supply.Amount, After: supply.Amount.AddRaw(1000)},
+	}, result.Supply)
+	require.Equal(t, []gaia.BalanceChange{
+		{Address: recipient.String(), Denom: sdk.DefaultBondDenom, Before: sdkmath.ZeroInt(), After: sdkmath.NewInt(1000)},
+	}, result.Balances)
+	require.Len(t, result.Params, 1)
+	require.Equal(t, "/cosmos.staking.v1beta1.Query/Params", result.Params[0].Query)
+	require.NotEqual(t, result.Params[0].Before, result.Params[0].After)
+
+	// the dry run does not change the state
+	require.Equal(t, supply, app.BankKeeper.GetSupply(ctx, sdk.DefaultBondDenom))
+	require.True(t, app.BankKeeper.GetAllBalances(ctx, recipient).IsZero())
+	require.Zero(t, app.UpgradeKeeper.GetDoneHeight(ctx, "dry-run"))
+
+	_, err = app.DryRunUpgrade(ctx, "dry-run-failure")
+	require.ErrorContains(t, err, "migration failed")
+
+	_, err = app.DryRunUpgrade(ctx, "dry-run-undeclared-mint")
+	require.ErrorContains(t, err, "post-upgrade checks of upgrade dry-run-undeclared-mint failed")
+	require.ErrorContains(t, err, "total supply of stake changed")
+
+	_, err = app.DryRunUpgrade(ctx, "unknown")
+	require.ErrorContains(t, err, "unknown upgrade")
+}
@@ -0,0 +1,199 @@
+package upgrades
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+
+	"github.com/cosmos/gaia/v17/app/keepers"
+)
+
+// SupplyChangeFn returns the coins an upgrade is expected to mint and burn.
+// It is called after the upgrade handler ran.
+type SupplyChangeFn func(ctx sdk.Context) (minted, burned sdk.Coins, err error)
+
+// WithPostUpgradeChecks wraps an upgrade handler so that the upgrade is
+// aborted, with an error listing every failed check, if the state left by the
+// handler does not pass the post-upgrade checks:
+//   - the returned version map matches the consensus versions of the modules
+//   - the module accounts have the permissions configured in the account keeper
+//   - the total supply is unchanged, except for the expected mint and burn
+//   - no crisis invariant is broken
+func WithPostUpgradeChecks(
+	handler upgradetypes.UpgradeHandler,
+	mm *module.Manager,
+	keepers *keepers.AppKeepers,
+	supplyChange SupplyChangeFn,
+) upgradetypes.UpgradeHandler {
+	return func(ctx sdk.Context, plan upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+		supply := TotalSupply(ctx, keepers)
+
+		vm, err := handler(ctx, plan, fromVM)
+		if err != nil {
+			return vm, err
+		}
+
+		ctx.Logger().Info("Running post-upgrade checks...", "upgrade", plan.Name)
+
+		errs := []error{
+			CheckVersionMap(mm, vm),
+			CheckModuleAccounts(ctx, keepers),
+		}
+		var minted, burned sdk.Coins
+		if supplyChange != nil {
+			minted, burned, err = supplyChange(ctx)
+		}
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to get the expected supply change: %w", err))
+		} else {
+			errs = append(errs, CheckSupply(supply, TotalSupply(ctx, keepers), minted, burned))
+		}
+		errs = append(errs, CheckInvariants(ctx, keepers))
+
+		if err := errors.Join(errs...); err != nil {
+			return vm, fmt.Errorf("post-upgrade checks of upgrade %s failed:\n%w", plan.Name, err)
+		}
+
+		ctx.Logger().Info("Post-upgrade checks passed", "upgrade", plan.Name)
+		return vm, nil
+	}
+}
+
+// CheckVersionMap checks that the version map returned by an upgrade handler
+// holds the consensus version of every module of the module manager, and no
+// other module.
+func CheckVersionMap(mm *module.Manager, vm module.VersionMap) error {
+	expected := mm.GetVersionMap()
+
+	var errs []error
+	for _, name := range sortedModules(expected) {
+		version, ok := vm[name]
+		switch {
+		case !ok:
+			errs = append(errs, fmt.Errorf("module %s is missing from the version map", name))
+		case version != expected[name]:
+			errs = append(errs, fmt.Errorf("module %s is at version %d, expected consensus version %d", name, version, expected[name]))
+		}
+	}
+	for _, name := range sortedModules(vm) {
+		if _, ok := expected[name]; !ok {
+			errs = append(errs, fmt.Errorf("unknown module %s in the version map", name))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// CheckModuleAccounts checks that every existing module account has the name
+// and the permissions configured in the account keeper, i.e. the app's module
+// account permissions. Module accounts that are not yet created are skipped.
+func CheckModuleAccounts(ctx sdk.Context, keepers *keepers.AppKeepers) error {
+	modulePerms := keepers.AccountKeeper.GetModulePermissions()
+	names := make([]string, 0, len(modulePerms))
+	for name := range modulePerms {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var errs []error
+	for _, name := range names {
+		perms := modulePerms[name]
+		acc := keepers.AccountKeeper.GetAccount(ctx, perms.GetAddress())
+		if acc == nil {
+			continue
+		}
+
+		macc, ok := acc.(authtypes.ModuleAccountI)
+		if !ok {
+			errs = append(errs, fmt.Errorf("account %s of module %s is not a module account", acc.GetAddress(), name))
+			continue
+		}
+		if macc.GetName() != name {
+			errs = append(errs, fmt.Errorf("module account %s has name %s, expected %s", macc.GetAddress(), macc.GetName(), name))
+		}
+		if !samePermissions(macc.GetPermissions(), perms.GetPermissions()) {
+			errs = append(errs, fmt.Errorf("module account %s has permissions %v, expected %v", name, macc.GetPermissions(), perms.GetPermissions()))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// CheckSupply checks that the total supply after an upgrade is the supply
+// before the upgrade plus the minted coins minus the burned coins.
+func CheckSupply(before, after, minted, burned sdk.Coins) error {
+	// compare before + minted with after + burned to never go negative
+	expected := before.Add(minted...)
+	actual := after.Add(burned...)
+
+	var errs []error
+	for _, coin := range expected.Add(actual...) {
+		if exp, act := expected.AmountOf(coin.Denom), actual.AmountOf(coin.Denom); !exp.Equal(act) {
+			errs = append(errs, fmt.Errorf(
+				"total supply of %s changed from %s to %s, expected minted %s and burned %s",
+				coin.Denom, before.AmountOf(coin.Denom), after.AmountOf(coin.Denom),
+				minted.AmountOf(coin.Denom), burned.AmountOf(coin.Denom),
+			))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// CheckInvariants runs every invariant registered in the crisis keeper and
+// returns an error for each broken one.
+func CheckInvariants(ctx sdk.Context, keepers *keepers.AppKeepers) error {
+	var errs []error
+	for _, route := range keepers.CrisisKeeper.Routes() {
+		if msg, broken := route.Invar(ctx); broken {
+			errs = append(errs, fmt.Errorf("invariant %s is broken: %s", route.FullRoute(), msg))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// TotalSupply returns the total supply of every denom.
+func TotalSupply(ctx sdk.Context, keepers *keepers.AppKeepers) sdk.Coins {
+	var supply sdk.Coins
+	keepers.BankKeeper.IterateTotalSupply(ctx, func(coin sdk.Coin) bool {
+		supply = supply.Add(coin)
+		return false
+	})
+
+	return supply
+}
+
+func samePermissions(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	perms := make(map[string]int, len(a))
+	for _, perm := range a {
+		perms[perm]++
+	}
+	for _, perm := range b {
+		if perms[perm] == 0 {
+			return false
+		}
+		perms[perm]--
+	}
+
+	return true
+}
+
+func sortedModules(vm module.VersionMap) []string {
+	names := make([]string, 0, len(vm))
+	for name := range vm {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
@@ -0,0 +1,100 @@
+package upgrades_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/cosmos/gaia/v17/app/helpers"
+	"github.com/cosmos/gaia/v17/app/upgrades"
+)
+
+func TestCheckSupply(t *testing.T) {
+	coins := func(amount int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewInt64Coin("uatom", amount))
+	}
+
+	tests := map[string]struct {
+		before, after, minted, burned sdk.Coins
+		expectErr                     bool
+	}{
+		"unchanged, pass": {
+			coins(100), coins(100), nil, nil,
+			false,
+		},
+		"expected mint and burn, pass": {
+			coins(100), coins(110), coins(20), coins(10),
+			false,
+		},
+		"new denom minted, pass": {
+			coins(100), coins(100).Add(sdk.NewInt64Coin("ibc/ABC", 5)), sdk.NewCoins(sdk.NewInt64Coin("ibc/ABC", 5)), nil,
+			false,
+		},
+		"unexpected mint, fail": {
+			coins(100), coins(101), nil, nil,
+			true,
+		},
+		"unexpected burn, fail": {
+			coins(100), coins(99), nil, nil,
+			true,
+		},
+		"mint lower than expected, fail": {
+			coins(100), coins(110), coins(20), nil,
+			true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := upgrades.CheckSupply(tc.before, tc.after, tc.minted, tc.burned)
+			if tc.expectErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestCheckVersionMap(t *testing.T) {
+	gaiaApp := helpers.Setup(t)
+	mm := module.NewManager(params.NewAppModule(gaiaApp.ParamsKeeper))
+
+	require.NoError(t, upgrades.CheckVersionMap(mm, module.VersionMap{paramstypes.ModuleName: 1}))
+	require.ErrorContains(t, upgrades.CheckVersionMap(mm, module.VersionMap{}), "module params is missing")
+	require.ErrorContains(t, upgrades.CheckVersionMap(mm, module.VersionMap{paramstypes.ModuleName: 2}), "expected consensus version 1")
+	require.ErrorContains(t, upgrades.CheckVersionMap(mm, module.VersionMap{paramstypes.ModuleName: 1, "removed": 1}), "unknown module removed")
+}
+
+func TestCheckModuleAccounts(t *testing.T) {
+	gaiaApp := helpers.Setup(t)
+	ctx := gaiaApp.NewUncachedContext(true, tmproto.Header{})
+	require.NoError(t, upgrades.CheckModuleAccounts(ctx, &gaiaApp.AppKeepers))
+
+	// the mint module account loses its minter permission
+	macc := gaiaApp.AccountKeeper.GetModuleAccount(ctx, minttypes.ModuleName).(*authtypes.ModuleAccount)
+	macc.Permissions = nil
+	gaiaApp.AccountKeeper.SetModuleAccount(ctx, macc)
+
+	require.ErrorContains(t, upgrades.CheckModuleAccounts(ctx, &gaiaApp.AppKeepers), "module account mint has permissions [], expected [minter]")
+}
+
+func TestCheckInvariants(t *testing.T) {
+	gaiaApp := helpers.Setup(t)
+	ctx := gaiaApp.NewUncachedContext(true, tmproto.Header{})
+	require.NoError(t, upgrades.CheckInvariants(ctx, &gaiaApp.AppKeepers))
+
+	// the recorded total escrow exceeds what the escrow accounts hold
+	gaiaApp.TransferKeeper.SetTotalEscrowForDenom(ctx, sdk.NewInt64Coin(sdk.DefaultBondDenom, 1))
+
+	require.ErrorContains(t, upgrades.CheckInvariants(ctx, &gaiaApp.AppKeepers), "invariant escrow/escrow-deficit is broken")
+}
@@ -0,0 +1,253 @@
+package upgrades
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"path"
+	"strings"
+
+	"sigs.k8s.io/yaml"
+
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DataValidator is a data file of an upgrade that can be checked without
+// running the upgrade.
+type DataValidator interface {
+	// Path returns the path of the file within its file system
+	Path() string
+	// Validate loads the file and validates its content
+	Validate() error
+}
+
+// Validatable is the content of an upgrade data file.
+type Validatable interface {
+	Validate() error
+}
+
+// DataFile is a versioned JSON or YAML data file embedded into the binary,
+// e.g. with go:embed, and used by an upgrade handler. The format is picked
+// from the file extension, .json, .yaml or .yml. The file holds an envelope
+// with the version of its format and the data:
+//
+//	{"version": 1, "data": ...}
+//
+// Unknown fields are rejected so that a typo in a reviewed file can not be
+// silently ignored.
+type DataFile[T Validatable] struct {
+	fsys    fs.FS
+	path    string
+	version uint32
+}
+
+var _ DataValidator = DataFile[RateLimits]{}
+
+// NewDataFile returns the data file at the given path of fsys, expected to be
+// in the given format version.
+func NewDataFile[T Validatable](fsys fs.FS, path string, version uint32) DataFile[T] {
+	return DataFile[T]{
+		fsys:    fsys,
+		path:    path,
+		version: version,
+	}
+}
+
+// Path implements DataValidator
+func (f DataFile[T]) Path() string {
+	return f.path
+}
+
+// Load reads, decodes and validates the data file.
+func (f DataFile[T]) Load() (data T, err error) {
+	bz, err := fs.ReadFile(f.fsys, f.path)
+	if err != nil {
+		return data, err
+	}
+
+	switch ext := path.Ext(f.path); ext {
+	case ".json":
+	case ".yaml", ".yml":
+		// YAML files are converted to JSON to be decoded as strictly
+		if bz, err = yaml.YAMLToJSON(bz); err != nil {
+			return data, fmt.Errorf("%s: %w", f.path, err)
+		}
+	default:
+		return data, fmt.Errorf("%s: unsupported data file extension %q", f.path, ext)
+	}
+
+	var envelope struct {
+		Version uint32 `json:"version"`
+		Data    T      `json:"data"`
+	}
+	decoder := json.NewDecoder(bytes.NewReader(bz))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&envelope); err != nil {
+		return data, fmt.Errorf("%s: %w", f.path, err)
+	}
+
+	if envelope.Version != f.version {
+		return data, fmt.Errorf("%s: unsupported version %d, expected %d", f.path, envelope.Version, f.version)
+	}
+
+	if err := envelope.Data.Validate(); err != nil {
+		return data, fmt.Errorf("%s: %w", f.path, err)
+	}
+
+	return envelope.Data, nil
+}
+
+// Validate implements DataValidator
+func (f DataFile[T]) Validate() error {
+	_, err := f.Load()
+	return err
+}
+
+// ValidateData validates the data files of every upgrade.
+func ValidateData(upgrades []Upgrade) error {
+	for _, upgrade := range upgrades {
+		for _, file := range upgrade.Data {
+			if err := file.Validate(); err != nil {
+				return fmt.Errorf("upgrade %s: %w", upgrade.UpgradeName, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// CheckEmbeddedData checks that every JSON or YAML file of fsys is declared as data
+// file of the upgrade and that all of them are valid. It is meant to be used
+// in the tests of an upgrade.
+func CheckEmbeddedData(fsys fs.FS, upgrade Upgrade) error {
+	declared := make(map[string]struct{}, len(upgrade.Data))
+	for _, file := range upgrade.Data {
+		declared[file.Path()] = struct{}{}
+	}
+
+	if err := fs.WalkDir(fsys, ".", func(p string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() || !isDataFile(p) {
+			return err
+		}
+
+		if _, ok := declared[p]; !ok {
+			return fmt.Errorf("%s is not declared as data file of upgrade %s", p, upgrade.UpgradeName)
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	return ValidateData([]Upgrade{upgrade})
+}
+
+// isDataFile returns true if the extension of p is one of a data file.
+func isDataFile(p string) bool {
+	switch path.Ext(p) {
+	case ".json", ".yaml", ".yml":
+		return true
+	default:
+		return false
+	}
+}
+
+// RateLimit is an IBC rate limit to add on a transfer channel.
+type RateLimit struct {
+	// ChainID is the chain ID of the counterparty, for reference only
+	ChainID        string      `json:"chain_id"`
+	ChannelID      string      `json:"channel_id"`
+	Denom          string      `json:"denom"`
+	MaxPercentSend sdkmath.Int `json:"max_percent_send"`
+	MaxPercentRecv sdkmath.Int `json:"max_percent_recv"`
+	DurationHours  uint64      `json:"duration_hours"`
+}
+
+// Validate checks the rate limit is well-formed.
+func (r RateLimit) Validate() error {
+	if strings.TrimSpace(r.ChainID) == "" {
+		return fmt.Errorf("rate limit on %s: chain id cannot be blank", r.ChannelID)
+	}
+	if err := host.ChannelIdentifierValidator(r.ChannelID); err != nil {
+		return fmt.Errorf("rate limit to %s: %w", r.ChainID, err)
+	}
+	if err := sdk.ValidateDenom(r.Denom); err != nil {
+		return fmt.Errorf("rate limit to %s: %w", r.ChainID, err)
+	}
+	for _, percent := range []sdkmath.Int{r.MaxPercentSend, r.MaxPercentRecv} {
+		if percent.IsNil() || percent.IsNegative() || percent.GT(sdkmath.NewInt(100)) {
+			return fmt.Errorf("rate limit to %s: max percentages must be between 0 and 100", r.ChainID)
+		}
+	}
+	if r.MaxPercentSend.IsZero() && r.MaxPercentRecv.IsZero() {
+		return fmt.Errorf("rate limit to %s: send and receive max percentages cannot both be 0", r.ChainID)
+	}
+	if r.DurationHours == 0 {
+		return fmt.Errorf("rate limit to %s: duration cannot be 0", r.ChainID)
+	}
+
+	return nil
+}
+
+// RateLimits is a table of IBC rate limits, at most one per channel and denom.
+type RateLimits []RateLimit
+
+// Validate implements Validatable
+func (r RateLimits) Validate() error {
+	paths := make(map[string]struct{}, len(r))
+	for _, rateLimit := range r {
+		if err := rateLimit.Validate(); err != nil {
+			return err
+		}
+
+		path := rateLimit.ChannelID + "/" + rateLimit.Denom
+		if _, ok := paths[path]; ok {
+			return fmt.Errorf("duplicate rate limit for %s on %s", rateLimit.Denom, rateLimit.ChannelID)
+		}
+		paths[path] = struct{}{}
+	}
+
+	return nil
+}
+
+// CoinsUpdate is an amount of coins to add to an account.
+type CoinsUpdate struct {
+	Address string    `json:"address"`
+	Coins   sdk.Coins `json:"coins"`
+}
+
+// Validate checks the address and the coins of the update.
+func (u CoinsUpdate) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(u.Address); err != nil {
+		return fmt.Errorf("invalid address %s: %w", u.Address, err)
+	}
+	if u.Coins.Empty() || !u.Coins.IsValid() {
+		return fmt.Errorf("invalid coins %s for %s", u.Coins, u.Address)
+	}
+
+	return nil
+}
+
+// CoinsUpdates is a list of coins to add to accounts, at most one entry per account.
+type CoinsUpdates []CoinsUpdate
+
+// Validate implements Validatable
+func (u CoinsUpdates) Validate() error {
+	addresses := make(map[string]struct{}, len(u))
+	for _, update := range u {
+		if err := update.Validate(); err != nil {
+			return err
+		}
+
+		if _, ok := addresses[update.Address]; ok {
+			return fmt.Errorf("duplicate coins update for %s", update.Address)
+		}
+		addresses[update.Address] = struct{}{}
+	}
+
+	return nil
+}
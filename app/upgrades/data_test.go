@@ -0,0 +1,143 @@
+package upgrades
+
+import (
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const testAddress = "cosmos1x54ltnyg88k0ejmk8ytwrhd3ltm84xehrnlslf"
+
+func TestDataFileLoad(t *testing.T) {
+	fsys := fstest.MapFS{
+		"data/valid.json": {Data: []byte(`{"version": 2, "data": [
+			{"address": "` + testAddress + `", "coins": [{"denom": "uatom", "amount": "4388000000000000000000"}]}
+		]}`)},
+		"data/unknown_field.json": {Data: []byte(`{"version": 2, "data": [
+			{"address": "` + testAddress + `", "coin": [{"denom": "uatom", "amount": "1"}]}
+		]}`)},
+		"data/invalid_address.json": {Data: []byte(`{"version": 2, "data": [
+			{"address": "osmo1x54ltnyg88k0ejmk8ytwrhd3ltm84xehrnlslf", "coins": [{"denom": "uatom", "amount": "1"}]}
+		]}`)},
+		"data/valid.yaml": {Data: []byte(`version: 2
+data:
+  - address: ` + testAddress + `
+    coins:
+      - denom: uatom
+        amount: "4388000000000000000000"
+`)},
+		"data/unknown_field.yml": {Data: []byte(`version: 2
+data:
+  - address: ` + testAddress + `
+    coin:
+      - denom: uatom
+        amount: "1"
+`)},
+		"data/valid.txt": {Data: []byte(`{"version": 2, "data": []}`)},
+		"data/duplicate_address.json": {Data: []byte(`{"version": 2, "data": [
+			{"address": "` + testAddress + `", "coins": [{"denom": "uatom", "amount": "1"}]},
+			{"address": "` + testAddress + `", "coins": [{"denom": "uosmo", "amount": "1"}]}
+		]}`)},
+	}
+
+	amount, ok := sdkmath.NewIntFromString("4388000000000000000000")
+	require.True(t, ok)
+
+	updates, err := NewDataFile[CoinsUpdates](fsys, "data/valid.json", 2).Load()
+	require.NoError(t, err)
+	require.Equal(t, CoinsUpdates{{Address: testAddress, Coins: sdk.NewCoins(sdk.NewCoin("uatom", amount))}}, updates)
+
+	updates, err = NewDataFile[CoinsUpdates](fsys, "data/valid.yaml", 2).Load()
+	require.NoError(t, err)
+	require.Equal(t, CoinsUpdates{{Address: testAddress, Coins: sdk.NewCoins(sdk.NewCoin("uatom", amount))}}, updates)
+
+	_, err = NewDataFile[CoinsUpdates](fsys, "data/unknown_field.yml", 2).Load()
+	require.ErrorContains(t, err, "unknown field")
+
+	_, err = NewDataFile[CoinsUpdates](fsys, "data/valid.txt", 2).Load()
+	require.ErrorContains(t, err, "unsupported data file extension")
+
+	_, err = NewDataFile[CoinsUpdates](fsys, "data/valid.json", 1).Load()
+	require.ErrorContains(t, err, "unsupported version 2, expected 1")
+
+	_, err = NewDataFile[CoinsUpdates](fsys, "data/unknown_field.json", 2).Load()
+	require.ErrorContains(t, err, "unknown field")
+
+	_, err = NewDataFile[CoinsUpdates](fsys, "data/invalid_address.json", 2).Load()
+	require.ErrorContains(t, err, "invalid address")
+
+	_, err = NewDataFile[CoinsUpdates](fsys, "data/duplicate_address.json", 2).Load()
+	require.ErrorContains(t, err, "duplicate coins update")
+
+	_, err = NewDataFile[CoinsUpdates](fsys, "data/missing.json", 2).Load()
+	require.Error(t, err)
+}
+
+func TestRateLimitsValidate(t *testing.T) {
+	valid := RateLimit{
+		ChainID:        "osmosis-1",
+		ChannelID:      "channel-141",
+		Denom:          "uatom",
+		MaxPercentSend: sdkmath.NewInt(5),
+		MaxPercentRecv: sdkmath.NewInt(5),
+		DurationHours:  24,
+	}
+
+	tests := map[string]struct {
+		malleate  func(r *RateLimit)
+		expectErr bool
+	}{
+		"valid, pass":                 {func(r *RateLimit) {}, false},
+		"one percentage zero, pass":   {func(r *RateLimit) { r.MaxPercentSend = sdkmath.ZeroInt() }, false},
+		"blank chain id, fail":        {func(r *RateLimit) { r.ChainID = "" }, true},
+		"invalid channel id, fail":    {func(r *RateLimit) { r.ChannelID = "141" }, true},
+		"invalid denom, fail":         {func(r *RateLimit) { r.Denom = "1atom" }, true},
+		"missing percentage, fail":    {func(r *RateLimit) { r.MaxPercentRecv = sdkmath.Int{} }, true},
+		"percentage above 100, fail":  {func(r *RateLimit) { r.MaxPercentSend = sdkmath.NewInt(101) }, true},
+		"both percentages zero, fail": {func(r *RateLimit) { r.MaxPercentSend, r.MaxPercentRecv = sdkmath.ZeroInt(), sdkmath.ZeroInt() }, true},
+		"zero duration, fail":         {func(r *RateLimit) { r.DurationHours = 0 }, true},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			rateLimit := valid
+			test.malleate(&rateLimit)
+
+			err := RateLimits{rateLimit}.Validate()
+			if test.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+
+	other := valid
+	other.Denom = "uosmo"
+	require.NoError(t, RateLimits{valid, other}.Validate())
+	require.ErrorContains(t, RateLimits{valid, valid}.Validate(), "duplicate rate limit")
+}
+
+func TestCheckEmbeddedData(t *testing.T) {
+	fsys := fstest.MapFS{
+		"data/updates.json": {Data: []byte(`{"version": 1, "data": []}`)},
+		"data/README.md":    {Data: []byte("not a data file")},
+	}
+	upgrade := Upgrade{
+		UpgradeName: "test",
+		Data:        []DataValidator{NewDataFile[CoinsUpdates](fsys, "data/updates.json", 1)},
+	}
+	require.NoError(t, CheckEmbeddedData(fsys, upgrade))
+
+	fsys["data/undeclared.json"] = &fstest.MapFile{Data: []byte(`{"version": 1, "data": []}`)}
+	require.ErrorContains(t, CheckEmbeddedData(fsys, upgrade), "data/undeclared.json is not declared")
+
+	delete(fsys, "data/undeclared.json")
+	fsys["data/undeclared.yaml"] = &fstest.MapFile{Data: []byte("version: 1\ndata: []\n")}
+	require.ErrorContains(t, CheckEmbeddedData(fsys, upgrade), "data/undeclared.yaml is not declared")
+}
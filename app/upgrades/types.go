@@ -1,6 +1,9 @@
 package upgrades
 
 import (
+	"fmt"
+	"strings"
+
 	store "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
@@ -22,6 +25,15 @@ type Upgrade struct {
 
 	// Store upgrades, should be used for any new modules introduced, new modules deleted, or store names renamed.
 	StoreUpgrades store.StoreUpgrades
+
+	// Data files embedded into the binary and used by the upgrade handler,
+	// validated on app start.
+	Data []DataValidator
+
+	// SupplyChange returns the coins minted and burned by the upgrade handler,
+	// checked against the total supply after the upgrade. Nil if the upgrade
+	// does not change the supply.
+	SupplyChange SupplyChangeFn
 }
 
 // Fork defines a struct containing the requisite fields for a non-software upgrade proposal
@@ -34,7 +46,50 @@ type Fork struct {
 	UpgradeName string
 	// height the upgrade occurs at
 	UpgradeHeight int64
+	// chain ID of the network the fork applies to, e.g. `cosmoshub-4`,
+	// the fork never runs on other networks
+	ChainID string
 
 	// Function that runs some custom state transition code at the beginning of a fork.
 	BeginForkLogic func(ctx sdk.Context, keepers *keepers.AppKeepers)
 }
+
+// ShouldRun returns true if the fork is scheduled for the block height and
+// chain ID of the given context.
+func (f Fork) ShouldRun(ctx sdk.Context) bool {
+	return ctx.BlockHeight() == f.UpgradeHeight && ctx.ChainID() == f.ChainID
+}
+
+// ValidateForks checks that every fork is complete and that no two forks of
+// the same network share a name or a height.
+func ValidateForks(forks []Fork) error {
+	names := make(map[string]struct{}, len(forks))
+	heights := make(map[string]struct{}, len(forks))
+	for _, fork := range forks {
+		if strings.TrimSpace(fork.UpgradeName) == "" {
+			return fmt.Errorf("fork at height %d has no name", fork.UpgradeHeight)
+		}
+		if strings.TrimSpace(fork.ChainID) == "" {
+			return fmt.Errorf("fork %s has no chain ID", fork.UpgradeName)
+		}
+		if fork.UpgradeHeight <= 0 {
+			return fmt.Errorf("fork %s has invalid height %d", fork.UpgradeName, fork.UpgradeHeight)
+		}
+		if fork.BeginForkLogic == nil {
+			return fmt.Errorf("fork %s has no BeginForkLogic", fork.UpgradeName)
+		}
+
+		if _, ok := names[fork.UpgradeName]; ok {
+			return fmt.Errorf("duplicate fork name %s", fork.UpgradeName)
+		}
+		names[fork.UpgradeName] = struct{}{}
+
+		height := fmt.Sprintf("%s/%d", fork.ChainID, fork.UpgradeHeight)
+		if _, ok := heights[height]; ok {
+			return fmt.Errorf("fork %s: another fork is scheduled on %s at height %d", fork.UpgradeName, fork.ChainID, fork.UpgradeHeight)
+		}
+		heights[height] = struct{}{}
+	}
+
+	return nil
+}
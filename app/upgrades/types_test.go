@@ -0,0 +1,75 @@
+package upgrades
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/gaia/v17/app/keepers"
+)
+
+func TestValidateForks(t *testing.T) {
+	logic := func(sdk.Context, *keepers.AppKeepers) {}
+	fork := func(name, chainID string, height int64) Fork {
+		return Fork{UpgradeName: name, UpgradeHeight: height, ChainID: chainID, BeginForkLogic: logic}
+	}
+
+	tests := map[string]struct {
+		forks     []Fork
+		expectErr bool
+	}{
+		"no forks, pass": {
+			nil,
+			false,
+		},
+		"same height on different networks, pass": {
+			[]Fork{fork("a", "cosmoshub-4", 10), fork("b", "theta-testnet-001", 10)},
+			false,
+		},
+		"blank name, fail": {
+			[]Fork{fork("", "cosmoshub-4", 10)},
+			true,
+		},
+		"blank chain id, fail": {
+			[]Fork{fork("a", "", 10)},
+			true,
+		},
+		"zero height, fail": {
+			[]Fork{fork("a", "cosmoshub-4", 0)},
+			true,
+		},
+		"no fork logic, fail": {
+			[]Fork{{UpgradeName: "a", UpgradeHeight: 10, ChainID: "cosmoshub-4"}},
+			true,
+		},
+		"duplicate name, fail": {
+			[]Fork{fork("a", "cosmoshub-4", 10), fork("a", "cosmoshub-4", 20)},
+			true,
+		},
+		"same height on the same network, fail": {
+			[]Fork{fork("a", "cosmoshub-4", 10), fork("b", "cosmoshub-4", 10)},
+			true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := ValidateForks(test.forks)
+			if test.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestForkShouldRun(t *testing.T) {
+	fork := Fork{UpgradeName: "a", UpgradeHeight: 10, ChainID: "cosmoshub-4"}
+
+	require.True(t, fork.ShouldRun(sdk.Context{}.WithBlockHeight(10).WithChainID("cosmoshub-4")))
+	require.False(t, fork.ShouldRun(sdk.Context{}.WithBlockHeight(11).WithChainID("cosmoshub-4")))
+	require.False(t, fork.ShouldRun(sdk.Context{}.WithBlockHeight(10).WithChainID("theta-testnet-001")))
+}
@@ -1,6 +1,8 @@
 package v15
 
 import (
+	"embed"
+
 	store "github.com/cosmos/cosmos-sdk/store/types"
 	consensustypes "github.com/cosmos/cosmos-sdk/x/consensus/types"
 	crisistypes "github.com/cosmos/cosmos-sdk/x/crisis/types"
@@ -13,6 +15,12 @@ const (
 	UpgradeName = "v15"
 )
 
+//go:embed data
+var dataFS embed.FS
+
+// EscrowUpdatesFile holds the coins missing in the IBC transfer escrow accounts
+var EscrowUpdatesFile = upgrades.NewDataFile[upgrades.CoinsUpdates](dataFS, "data/escrow_updates.json", 1)
+
 var Upgrade = upgrades.Upgrade{
 	UpgradeName:          UpgradeName,
 	CreateUpgradeHandler: CreateUpgradeHandler,
@@ -23,4 +31,6 @@ var Upgrade = upgrades.Upgrade{
 			consensustypes.ModuleName,
 		},
 	},
+	Data:         []upgrades.DataValidator{EscrowUpdatesFile},
+	SupplyChange: EscrowSupplyChange,
 }
@@ -72,7 +72,9 @@ func CreateUpgradeHandler(
 			return nil, fmt.Errorf("failed initializing the min initial deposit ratio: %s", err)
 		}
 
-		UpgradeEscrowAccounts(ctx, keepers.BankKeeper, keepers.TransferKeeper)
+		if err := UpgradeEscrowAccounts(ctx, keepers.BankKeeper, keepers.TransferKeeper); err != nil {
+			return nil, fmt.Errorf("failed upgrading escrow accounts: %s", err)
+		}
 
 		ctx.Logger().Info("Upgrade v15 complete")
 		return vm, err
@@ -358,7 +360,8 @@ func SetMinInitialDepositRatio(ctx sdk.Context, gk govkeeper.Keeper) error {
 }
 
 /*
-The following is a list of the discrepancies that were found in the IBC transfer escrow accounts.
+The following is a list of the discrepancies that were found in the IBC transfer escrow accounts,
+the missing amounts are listed in data/escrow_updates.json.
 Please note that discrepancies #1 and #3 are for the same escrow account address, but for coins of
 a different denomination.
 
@@ -392,8 +395,13 @@ Discrepancy #3:
 
 // UpgradeEscrowAccounts mints the necessary assets to reach parity between the escrow account
 // and the counterparty total supply, and then, send them from the transfer module to the escrow account.
-func UpgradeEscrowAccounts(ctx sdk.Context, bankKeeper bankkeeper.Keeper, transferKeeper ibctransferkeeper.Keeper) {
-	for _, update := range GetEscrowUpdates(ctx) {
+func UpgradeEscrowAccounts(ctx sdk.Context, bankKeeper bankkeeper.Keeper, transferKeeper ibctransferkeeper.Keeper) error {
+	escrowUpdates, err := EscrowUpdatesFile.Load()
+	if err != nil {
+		return err
+	}
+
+	for _, update := range escrowUpdates {
 		escrowAddress := sdk.MustAccAddressFromBech32(update.Address)
 		for _, coin := range update.Coins {
 			coins := sdk.NewCoins(coin)
@@ -412,47 +420,21 @@ func UpgradeEscrowAccounts(ctx sdk.Context, bankKeeper bankkeeper.Keeper, transf
 			transferKeeper.SetTotalEscrowForDenom(ctx, newTotalEscrow)
 		}
 	}
-}
 
-type UpdateCoins struct {
-	Address string
-	Coins   sdk.Coins
+	return nil
 }
 
-func GetEscrowUpdates(ctx sdk.Context) []UpdateCoins {
-	escrowUpdates := []UpdateCoins{
-		{
-			// discrepancy #1
-			Address: "cosmos1x54ltnyg88k0ejmk8ytwrhd3ltm84xehrnlslf",
-			Coins: sdk.Coins{{
-				Denom:  "ibc/4925E6ABA571A44D2BE0286D2D29AF42A294D0FF2BB16490149A1B26EAD33729",
-				Amount: sdk.NewInt(40000000000000000),
-			}},
-		},
-		{
-			// discrepancy #2
-			Address: "cosmos1ju6tlfclulxumtt2kglvnxduj5d93a64r5czge",
-			Coins: sdk.Coins{{
-				Denom:  "ibc/14F9BC3E44B8A9C1BE1FB08980FAB87034C9905EF17CF2F5008FC085218811CC",
-				Amount: sdk.NewInt(2000),
-			}},
-		},
+// EscrowSupplyChange returns the coins minted by UpgradeEscrowAccounts, the
+// only change of the total supply made by the upgrade.
+func EscrowSupplyChange(sdk.Context) (minted, burned sdk.Coins, err error) {
+	escrowUpdates, err := EscrowUpdatesFile.Load()
+	if err != nil {
+		return nil, nil, err
 	}
 
-	// For discrepancy #3, the missing amount in the escrow account is too large
-	// to be represented using an 64-bit integer. Therefore, it's added to the
-	// escrow updates list under the condition that the amount is successfully
-	// converted to the sdk.Int type.
-	if amt, ok := sdk.NewIntFromString("4388000000000000000000"); !ok {
-		ctx.Logger().Error("can't upgrade missing amount in escrow account: '4388000000000000000000'")
-	} else {
-		coins := escrowUpdates[0].Coins
-		coins = coins.Add(sdk.NewCoins(sdk.NewCoin(
-			"ibc/F5ED5F3DC6F0EF73FA455337C027FE91ABCB375116BF51A228E44C493E020A09",
-			amt,
-		))...)
-		escrowUpdates[0].Coins = coins
+	for _, update := range escrowUpdates {
+		minted = minted.Add(update.Coins...)
 	}
 
-	return escrowUpdates
+	return minted, nil, nil
 }
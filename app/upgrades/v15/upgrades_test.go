@@ -1,6 +1,7 @@
 package v15_test
 
 import (
+	"os"
 	"testing"
 	"time"
 
@@ -23,6 +24,7 @@ import (
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	"github.com/cosmos/gaia/v17/app/helpers"
+	"github.com/cosmos/gaia/v17/app/upgrades"
 	v15 "github.com/cosmos/gaia/v17/app/upgrades/v15"
 )
 
@@ -291,7 +293,8 @@ func TestUpgradeEscrowAccounts(t *testing.T) {
 	bankKeeper := gaiaApp.BankKeeper
 	transferKeeper := gaiaApp.TransferKeeper
 
-	escrowUpdates := v15.GetEscrowUpdates(ctx)
+	escrowUpdates, err := v15.EscrowUpdatesFile.Load()
+	require.NoError(t, err)
 
 	// check escrow accounts are empty
 	for _, update := range escrowUpdates {
@@ -302,7 +305,7 @@ func TestUpgradeEscrowAccounts(t *testing.T) {
 	}
 
 	// execute the upgrade
-	v15.UpgradeEscrowAccounts(ctx, bankKeeper, transferKeeper)
+	require.NoError(t, v15.UpgradeEscrowAccounts(ctx, bankKeeper, transferKeeper))
 
 	// check that new assets are minted and transferred to the escrow accounts
 	numUpdate := 0
@@ -318,3 +321,7 @@ func TestUpgradeEscrowAccounts(t *testing.T) {
 	// verify that all tree discrepancies are covered in the update
 	require.Equal(t, 3, numUpdate)
 }
+
+func TestEmbeddedData(t *testing.T) {
+	require.NoError(t, upgrades.CheckEmbeddedData(os.DirFS("."), v15.Upgrade))
+}
@@ -1,6 +1,8 @@
 package v16
 
 import (
+	"embed"
+
 	ratelimittypes "github.com/Stride-Labs/ibc-rate-limiting/ratelimit/types"
 
 	icacontrollertypes "github.com/cosmos/ibc-go/v7/modules/apps/27-interchain-accounts/controller/types"
@@ -15,10 +17,18 @@ const (
 	// UpgradeName defines the on-chain upgrade name.
 	UpgradeName = "v16"
 
-	RateLimitDenom         = "uatom"
+	// RateLimitDenom is the denom of the rate limits of RateLimitsFile.
+	RateLimitDenom = "uatom"
+	// RateLimitDurationHours is the duration of the rate limits of RateLimitsFile.
 	RateLimitDurationHours = 24
 )
 
+//go:embed data
+var dataFS embed.FS
+
+// RateLimitsFile holds the initial rate limits as per https://www.mintscan.io/cosmos/proposals/890
+var RateLimitsFile = upgrades.NewDataFile[upgrades.RateLimits](dataFS, "data/rate_limits.json", 1)
+
 var Upgrade = upgrades.Upgrade{
 	UpgradeName:          UpgradeName,
 	CreateUpgradeHandler: CreateUpgradeHandler,
@@ -29,4 +39,5 @@ var Upgrade = upgrades.Upgrade{
 			ibcfeetypes.ModuleName,
 		},
 	},
+	Data: []upgrades.DataValidator{RateLimitsFile},
 }
@@ -11,7 +11,6 @@ import (
 	providertypes "github.com/cosmos/interchain-security/v4/x/ccv/provider/types"
 
 	errorsmod "cosmossdk.io/errors"
-	sdkmath "cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
@@ -21,44 +20,6 @@ import (
 	"github.com/cosmos/gaia/v17/app/keepers"
 )
 
-var RateLimits = map[string]ratelimittypes.MsgAddRateLimit{
-	"osmosis-1": {
-		MaxPercentSend: sdkmath.NewInt(5),
-		MaxPercentRecv: sdkmath.NewInt(5),
-		ChannelId:      "channel-141",
-	},
-	"neutron-1": {
-		MaxPercentSend: sdkmath.NewInt(1),
-		MaxPercentRecv: sdkmath.NewInt(1),
-		ChannelId:      "channel-569",
-	},
-	"stride-1": {
-		MaxPercentSend: sdkmath.NewInt(1),
-		MaxPercentRecv: sdkmath.NewInt(1),
-		ChannelId:      "channel-391",
-	},
-	"kaiyo-1": { // Kujira
-		MaxPercentSend: sdkmath.NewInt(1),
-		MaxPercentRecv: sdkmath.NewInt(1),
-		ChannelId:      "channel-343",
-	},
-	"injective-1": {
-		MaxPercentSend: sdkmath.NewInt(1),
-		MaxPercentRecv: sdkmath.NewInt(1),
-		ChannelId:      "channel-220",
-	},
-	"core-1": { // Persistence
-		MaxPercentSend: sdkmath.NewInt(1),
-		MaxPercentRecv: sdkmath.NewInt(1),
-		ChannelId:      "channel-190",
-	},
-	"secret-4": {
-		MaxPercentSend: sdkmath.NewInt(1),
-		MaxPercentRecv: sdkmath.NewInt(1),
-		ChannelId:      "channel-235",
-	},
-}
-
 func CreateUpgradeHandler(
 	mm *module.Manager,
 	configurator module.Configurator,
@@ -103,60 +64,22 @@ func CreateUpgradeHandler(
 func AddRateLimits(ctx sdk.Context, k ratelimitkeeper.Keeper) error {
 	ctx.Logger().Info("Adding rate limits...")
 
-	// Osmosis
-	msg := RateLimits["osmosis-1"]
-	msg.DurationHours = RateLimitDurationHours
-	msg.Denom = RateLimitDenom
-	if err := k.AddRateLimit(ctx, &msg); err != nil {
-		return errorsmod.Wrapf(err, "unable to add rate limit on %s to osmosis-1", msg.ChannelId)
-	}
-
-	// Neutron
-	msg = RateLimits["neutron-1"]
-	msg.DurationHours = RateLimitDurationHours
-	msg.Denom = RateLimitDenom
-	if err := k.AddRateLimit(ctx, &msg); err != nil {
-		return errorsmod.Wrapf(err, "unable to add rate limit on %s to neutron-1", msg.ChannelId)
-	}
-
-	// Stride
-	msg = RateLimits["stride-1"]
-	msg.DurationHours = RateLimitDurationHours
-	msg.Denom = RateLimitDenom
-	if err := k.AddRateLimit(ctx, &msg); err != nil {
-		return errorsmod.Wrapf(err, "unable to add rate limit on %s to stride-1", msg.ChannelId)
-	}
-
-	// Kujira
-	msg = RateLimits["kaiyo-1"]
-	msg.DurationHours = RateLimitDurationHours
-	msg.Denom = RateLimitDenom
-	if err := k.AddRateLimit(ctx, &msg); err != nil {
-		return errorsmod.Wrapf(err, "unable to add rate limit on %s to kaiyo-1", msg.ChannelId)
-	}
-
-	// Injective
-	msg = RateLimits["injective-1"]
-	msg.DurationHours = RateLimitDurationHours
-	msg.Denom = RateLimitDenom
-	if err := k.AddRateLimit(ctx, &msg); err != nil {
-		return errorsmod.Wrapf(err, "unable to add rate limit on %s to injective-1", msg.ChannelId)
+	rateLimits, err := RateLimitsFile.Load()
+	if err != nil {
+		return err
 	}
 
-	// Persistence
-	msg = RateLimits["core-1"]
-	msg.DurationHours = RateLimitDurationHours
-	msg.Denom = RateLimitDenom
-	if err := k.AddRateLimit(ctx, &msg); err != nil {
-		return errorsmod.Wrapf(err, "unable to add rate limit on %s to core-1", msg.ChannelId)
-	}
-
-	// Secret
-	msg = RateLimits["secret-4"]
-	msg.DurationHours = RateLimitDurationHours
-	msg.Denom = RateLimitDenom
-	if err := k.AddRateLimit(ctx, &msg); err != nil {
-		return errorsmod.Wrapf(err, "unable to add rate limit on %s to secret-4", msg.ChannelId)
+	for _, rateLimit := range rateLimits {
+		msg := ratelimittypes.MsgAddRateLimit{
+			Denom:          rateLimit.Denom,
+			ChannelId:      rateLimit.ChannelID,
+			MaxPercentSend: rateLimit.MaxPercentSend,
+			MaxPercentRecv: rateLimit.MaxPercentRecv,
+			DurationHours:  rateLimit.DurationHours,
+		}
+		if err := k.AddRateLimit(ctx, &msg); err != nil {
+			return errorsmod.Wrapf(err, "unable to add rate limit on %s to %s", msg.ChannelId, rateLimit.ChainID)
+		}
 	}
 
 	ctx.Logger().Info("Finished adding rate limits")
@@ -1,7 +1,7 @@
 package v16_test
 
 import (
-	"strings"
+	"os"
 	"testing"
 
 	ratelimittypes "github.com/Stride-Labs/ibc-rate-limiting/ratelimit/types"
@@ -18,11 +18,27 @@ import (
 	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
 
 	"github.com/cosmos/gaia/v17/app/helpers"
+	"github.com/cosmos/gaia/v17/app/upgrades"
 	v16 "github.com/cosmos/gaia/v17/app/upgrades/v16"
 )
 
 var AtomSupply = sdkmath.NewInt(1000)
 
+// prop890RateLimits are the rate limits of https://www.mintscan.io/cosmos/proposals/890
+var prop890RateLimits = []struct {
+	chainID    string
+	channelID  string
+	maxPercent int64
+}{
+	{"osmosis-1", "channel-141", 5},
+	{"neutron-1", "channel-569", 1},
+	{"stride-1", "channel-391", 1},
+	{"kaiyo-1", "channel-343", 1},
+	{"injective-1", "channel-220", 1},
+	{"core-1", "channel-190", 1},
+	{"secret-4", "channel-235", 1},
+}
+
 func TestAddRateLimits(t *testing.T) {
 	gaiaApp := helpers.Setup(t)
 	ctx := gaiaApp.NewUncachedContext(true, tmproto.Header{})
@@ -34,23 +50,34 @@ func TestAddRateLimits(t *testing.T) {
 	err := gaiaApp.BankKeeper.MintCoins(ctx, minttypes.ModuleName, amountCoins)
 	require.NoError(t, err)
 
+	// the embedded file holds the rate limits of the proposal
+	rateLimits, err := v16.RateLimitsFile.Load()
+	require.NoError(t, err)
+	require.Len(t, rateLimits, len(prop890RateLimits))
+	for i, expected := range prop890RateLimits {
+		require.Equal(t, upgrades.RateLimit{
+			ChainID:        expected.chainID,
+			ChannelID:      expected.channelID,
+			Denom:          v16.RateLimitDenom,
+			MaxPercentSend: sdkmath.NewInt(expected.maxPercent),
+			MaxPercentRecv: sdkmath.NewInt(expected.maxPercent),
+			DurationHours:  v16.RateLimitDurationHours,
+		}, rateLimits[i])
+	}
+
 	// mock IBC channels
-	gaiaApp.IBCKeeper.ChannelKeeper.SetChannel(ctx, transfertypes.PortID, v16.RateLimits["osmosis-1"].ChannelId, channeltypes.Channel{})
-	gaiaApp.IBCKeeper.ChannelKeeper.SetChannel(ctx, transfertypes.PortID, v16.RateLimits["neutron-1"].ChannelId, channeltypes.Channel{})
-	gaiaApp.IBCKeeper.ChannelKeeper.SetChannel(ctx, transfertypes.PortID, v16.RateLimits["stride-1"].ChannelId, channeltypes.Channel{})
-	gaiaApp.IBCKeeper.ChannelKeeper.SetChannel(ctx, transfertypes.PortID, v16.RateLimits["kaiyo-1"].ChannelId, channeltypes.Channel{})
-	gaiaApp.IBCKeeper.ChannelKeeper.SetChannel(ctx, transfertypes.PortID, v16.RateLimits["injective-1"].ChannelId, channeltypes.Channel{})
-	gaiaApp.IBCKeeper.ChannelKeeper.SetChannel(ctx, transfertypes.PortID, v16.RateLimits["core-1"].ChannelId, channeltypes.Channel{})
-	gaiaApp.IBCKeeper.ChannelKeeper.SetChannel(ctx, transfertypes.PortID, v16.RateLimits["secret-4"].ChannelId, channeltypes.Channel{})
+	for _, expected := range prop890RateLimits {
+		gaiaApp.IBCKeeper.ChannelKeeper.SetChannel(ctx, transfertypes.PortID, expected.channelID, channeltypes.Channel{})
+	}
 
 	err = v16.AddRateLimits(ctx, ratelimitkeeper)
 	require.NoError(t, err)
 
-	for chain, msg := range v16.RateLimits {
+	for _, expected := range prop890RateLimits {
 		expectedRateLimit := ratelimittypes.RateLimit{
 			Path: &ratelimittypes.Path{
 				Denom:     v16.RateLimitDenom,
-				ChannelId: msg.ChannelId,
+				ChannelId: expected.channelID,
 			},
 			Flow: &ratelimittypes.Flow{
 				Inflow:       sdkmath.NewInt(0),
@@ -58,18 +85,17 @@ func TestAddRateLimits(t *testing.T) {
 				ChannelValue: AtomSupply,
 			},
 			Quota: &ratelimittypes.Quota{
-				DurationHours: v16.RateLimitDurationHours,
+				MaxPercentSend: sdkmath.NewInt(expected.maxPercent),
+				MaxPercentRecv: sdkmath.NewInt(expected.maxPercent),
+				DurationHours:  v16.RateLimitDurationHours,
 			},
 		}
-		if strings.Compare("osmosis-1", chain) == 0 {
-			expectedRateLimit.Quota.MaxPercentSend = sdkmath.NewInt(5)
-			expectedRateLimit.Quota.MaxPercentRecv = sdkmath.NewInt(5)
-		} else {
-			expectedRateLimit.Quota.MaxPercentSend = sdkmath.NewInt(1)
-			expectedRateLimit.Quota.MaxPercentRecv = sdkmath.NewInt(1)
-		}
-		rateLimit, found := ratelimitkeeper.GetRateLimit(ctx, v16.RateLimitDenom, msg.ChannelId)
-		require.True(t, found)
+		rateLimit, found := ratelimitkeeper.GetRateLimit(ctx, v16.RateLimitDenom, expected.channelID)
+		require.True(t, found, expected.chainID)
 		require.Equal(t, expectedRateLimit, rateLimit)
 	}
 }
+
+func TestEmbeddedData(t *testing.T) {
+	require.NoError(t, upgrades.CheckEmbeddedData(os.DirFS("."), v16.Upgrade))
+}
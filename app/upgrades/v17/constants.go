@@ -1,7 +1,13 @@
 package v17
 
 import (
+	store "github.com/cosmos/cosmos-sdk/store/types"
+
 	"github.com/cosmos/gaia/v17/app/upgrades"
+	circuitbreakertypes "github.com/cosmos/gaia/v17/x/circuitbreaker/types"
+	icqtypes "github.com/cosmos/gaia/v17/x/icq/types"
+	rewarddenomstypes "github.com/cosmos/gaia/v17/x/rewarddenoms/types"
+	txlimittypes "github.com/cosmos/gaia/v17/x/txlimit/types"
 )
 
 const (
@@ -12,4 +18,12 @@ const (
 var Upgrade = upgrades.Upgrade{
 	UpgradeName:          UpgradeName,
 	CreateUpgradeHandler: CreateUpgradeHandler,
+	StoreUpgrades: store.StoreUpgrades{
+		Added: []string{
+			icqtypes.StoreKey,
+			rewarddenomstypes.StoreKey,
+			circuitbreakertypes.StoreKey,
+			txlimittypes.StoreKey,
+		},
+	},
 }
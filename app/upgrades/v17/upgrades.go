@@ -21,6 +21,12 @@ func CreateUpgradeHandler(
 			return vm, err
 		}
 
+		// The ICA host and controller stacks are now wrapped by the IBC fee
+		// middleware. Fee incentivization is negotiated per channel during the
+		// handshake, so ICA channels opened before this upgrade keep their
+		// non-fee version and need no migration; new ICA channels can opt in
+		// by using the ics29 fee version.
+
 		ctx.Logger().Info("Upgrade v17 complete")
 		return vm, nil
 	}
@@ -0,0 +1,89 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+
+	gaia "github.com/cosmos/gaia/v17/app"
+	addressutil "github.com/cosmos/gaia/v17/pkg/address"
+)
+
+// AddressInfoCommand returns the address-info cobra Command.
+func AddressInfoCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "address-info [address]",
+		Short: "Describe an address given in bech32, bech32m, hex or base64",
+		Long: `Describe an address given in bech32, bech32m, hex or base64: its format, human
+readable part, checksum, length and kind, i.e. a 20-byte account or a 32-byte
+derived account such as an interchain account. The address is matched against
+the module accounts of the app and printed with the account, validator and
+consensus prefixes.
+
+Example:
+	gaiad debug address-info cosmos1fl48vsnmsdzcv85q5d2q4z5ajdha8yu34mf0eh
+	gaiad debug address-info 4FEA76427B8345861E80A3540A8A9D936FD39391 --output json
+	`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			output, err := cmd.Flags().GetString(flags.FlagOutput)
+			if err != nil {
+				return err
+			}
+
+			moduleNames := make([]string, 0, len(gaia.GetMaccPerms()))
+			for name := range gaia.GetMaccPerms() {
+				moduleNames = append(moduleNames, name)
+			}
+			sort.Strings(moduleNames)
+
+			info, err := addressutil.Inspect(args[0], moduleNames)
+			if err != nil {
+				return err
+			}
+
+			out := cmd.OutOrStdout()
+			switch output {
+			case "json":
+				bz, err := json.MarshalIndent(info, "", "  ")
+				if err != nil {
+					return err
+				}
+				fmt.Fprintln(out, string(bz))
+			case "text":
+				w := tabwriter.NewWriter(out, 0, 0, 1, ' ', 0)
+				fmt.Fprintf(w, "Format:\t%s\n", info.Format)
+				if info.HRP != "" {
+					fmt.Fprintf(w, "HRP:\t%s\n", info.HRP)
+					fmt.Fprintf(w, "Valid checksum:\t%t\n", info.ValidChecksum)
+				}
+				fmt.Fprintf(w, "Hex:\t%s\n", info.Hex)
+				fmt.Fprintf(w, "Length:\t%d bytes\n", info.Length)
+				fmt.Fprintf(w, "Kind:\t%s\n", info.Kind)
+				fmt.Fprintf(w, "Valid:\t%t\n", info.Valid)
+				if info.Module != "" {
+					fmt.Fprintf(w, "Module:\t%s\n", info.Module)
+				}
+				fmt.Fprintf(w, "Account:\t%s\n", info.Account)
+				fmt.Fprintf(w, "Validator:\t%s\n", info.Validator)
+				fmt.Fprintf(w, "Consensus:\t%s\n", info.Consensus)
+				if err := w.Flush(); err != nil {
+					return err
+				}
+			default:
+				return fmt.Errorf("unknown output %q, expected text or json", output)
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flags.FlagOutput, "text", "Output format (text|json)")
+
+	return cmd
+}
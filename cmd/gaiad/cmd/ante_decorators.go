@@ -0,0 +1,97 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	dbm "github.com/cometbft/cometbft-db"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+
+	gaia "github.com/cosmos/gaia/v17/app"
+	"github.com/cosmos/gaia/v17/app/params"
+)
+
+// anteDecorator describes a decorator of the ante handler.
+type anteDecorator struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+// AnteDecoratorsCommand returns the ante-decorators cobra Command.
+func AnteDecoratorsCommand(encodingConfig params.EncodingConfig) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "ante-decorators",
+		Short: "List the decorators of the ante handler of the node in order",
+		Long: `List the decorators of the ante handler in the order they run, as set up by
+the node with the app.toml of its home, e.g. with the [gaia] settings.
+
+The app is built in memory, the node may be running.
+
+Example:
+	gaiad debug ante-decorators --home ~/.gaia
+	`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			output, err := cmd.Flags().GetString(flags.FlagOutput)
+			if err != nil {
+				return err
+			}
+			serverCtx := server.GetServerContextFromCmd(cmd)
+
+			app := gaia.NewGaiaApp(
+				serverCtx.Logger, dbm.NewMemDB(), nil, false, map[int64]bool{}, serverCtx.Config.RootDir, encodingConfig, serverCtx.Viper,
+			)
+			decorators := make([]anteDecorator, len(app.AnteDecorators()))
+			for i, d := range app.AnteDecorators() {
+				decorators[i] = anteDecorator{Name: d.Name, Type: typeName(d.Decorator)}
+			}
+
+			out := cmd.OutOrStdout()
+			switch output {
+			case "json":
+				bz, err := json.MarshalIndent(decorators, "", "  ")
+				if err != nil {
+					return err
+				}
+				fmt.Fprintln(out, string(bz))
+			case "text":
+				w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+				for i, d := range decorators {
+					fmt.Fprintf(w, "%d\t%s\t%s\n", i, d.Name, d.Type)
+				}
+				if err := w.Flush(); err != nil {
+					return err
+				}
+			default:
+				return fmt.Errorf("unknown output %q, expected text or json", output)
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flags.FlagOutput, "text", "Output format (text|json)")
+
+	return cmd
+}
+
+// typeName returns the type name of the value qualified by its package path,
+// as the packages of the decorators often have the same name.
+func typeName(v interface{}) string {
+	t := reflect.TypeOf(v)
+	prefix := ""
+	if t.Kind() == reflect.Pointer {
+		t, prefix = t.Elem(), "*"
+	}
+	if t.PkgPath() == "" {
+		return prefix + t.String()
+	}
+
+	return prefix + t.PkgPath() + "." + t.Name()
+}
@@ -1,15 +1,40 @@
 package cmd
 
 import (
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
 
 	"github.com/spf13/cobra"
 
+	"github.com/cosmos/cosmos-sdk/client/flags"
+
+	gaia "github.com/cosmos/gaia/v17/app"
+	"github.com/cosmos/gaia/v17/app/params"
 	addressutil "github.com/cosmos/gaia/v17/pkg/address"
 )
 
 var flagBech32Prefix = "prefix"
 
+const (
+	flagInput    = "input"
+	flagAppHash  = "app-hash"
+	flagDataHash = "data-hash"
+)
+
+// bech32Result is the outcome of the conversion or the derivation of an address.
+type bech32Result struct {
+	Input   string `json:"input"`
+	Address string `json:"address,omitempty"`
+	Error   string `json:"error,omitempty"`
+}
+
 // AddBech32ConvertCommand returns bech32-convert cobra Command.
 func AddBech32ConvertCommand() *cobra.Command {
 	cmd := &cobra.Command{
@@ -17,37 +42,290 @@ func AddBech32ConvertCommand() *cobra.Command {
 		Short: "Convert any bech32 string to the cosmos prefix",
 		Long: `Convert any bech32 string to the cosmos prefix
 
+The addresses are read from the argument, or from the first column of a CSV
+file with --input ('-' reads from stdin). Empty lines, lines starting with '#'
+and an "address" header are skipped. Well-known addresses are derived with the
+subcommands.
+
 Example:
 	gaiad debug bech32-convert akash1a6zlyvpnksx8wr6wz8wemur2xe8zyh0ytz6d88
 
 	gaiad debug bech32-convert stride1673f0t8p893rqyqe420mgwwz92ac4qv6synvx2 --prefix osmo
+
+	cat addresses.csv | gaiad debug bech32-convert --input - --prefix osmo --output json
 	`,
-		Args: cobra.ExactArgs(1),
+		Args: cobra.RangeArgs(0, 1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			bech32prefix, err := cmd.Flags().GetString(flagBech32Prefix)
 			if err != nil {
 				return err
 			}
+			input, err := cmd.Flags().GetString(flagInput)
+			if err != nil {
+				return err
+			}
+
+			if (input == "") == (len(args) == 0) {
+				return errors.New("expected either an address or --input")
+			}
+			if input == "" {
+				// a single address keeps the plain output
+				address := args[0]
+				convertedAddress, err := addressutil.ConvertBech32Prefix(address, bech32prefix)
+				if err != nil {
+					return fmt.Errorf("convertation failed: %s", err)
+				}
+
+				return printBech32Results(cmd, []bech32Result{{Input: address, Address: convertedAddress}}, false, false)
+			}
+
+			addresses, err := readBech32Input(cmd.InOrStdin(), input)
+			if err != nil {
+				return err
+			}
+			results := make([]bech32Result, 0, len(addresses))
+			for _, address := range addresses {
+				convertedAddress, err := addressutil.ConvertBech32Prefix(address, bech32prefix)
+				results = append(results, newBech32Result(address, convertedAddress, err))
+			}
+
+			return printBech32Results(cmd, results, true, true)
+		},
+	}
+
+	cmd.PersistentFlags().StringP(flagBech32Prefix, "p", "cosmos", "Bech32 Prefix to encode to")
+	cmd.PersistentFlags().String(flags.FlagOutput, "text", "Output format (text|json)")
+	cmd.Flags().String(flagInput, "", "CSV file of the addresses to convert, '-' reads from stdin")
+
+	cmd.AddCommand(
+		bech32ModuleCommand(),
+		bech32ICACommand(),
+		bech32EscrowCommand(),
+	)
+
+	return cmd
+}
+
+func bech32ModuleCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "module [name...]",
+		Short: "Derive the address of module accounts",
+		Long: `Derive the address of the module accounts with the given names, or of all the
+module accounts of the app if no name is given.
 
-			address := args[0]
-			convertedAddress, err := addressutil.ConvertBech32Prefix(address, bech32prefix)
+Example:
+	gaiad debug bech32-convert module
+	gaiad debug bech32-convert module transfer bonded_tokens_pool --prefix osmo
+	`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			bech32prefix, err := cmd.Flags().GetString(flagBech32Prefix)
 			if err != nil {
-				return fmt.Errorf("convertation failed: %s", err)
+				return err
 			}
 
-			cmd.Println(convertedAddress)
+			names := args
+			if len(names) == 0 {
+				for name := range gaia.GetMaccPerms() {
+					names = append(names, name)
+				}
+				sort.Strings(names)
+			}
+			results := make([]bech32Result, 0, len(names))
+			for _, name := range names {
+				address, err := addressutil.ModuleAddress(name, bech32prefix)
+				results = append(results, newBech32Result(name, address, err))
+			}
 
-			return nil
+			// the names are only printed in text output when several are derived
+			return printBech32Results(cmd, results, true, len(args) != 1)
 		},
 	}
+}
+
+func bech32ICACommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "ica [connection-id] [owner]",
+		Short: "Derive the address of an interchain account on the host chain",
+		Long: `Derive the address of the interchain account registered on the host chain by
+the owner of the controller chain over the connection of the host chain.
+
+Since ibc-go v7, the address also depends on the app hash and the data hash of
+the header of the host chain block in which the channel was opened, i.e. the
+block of MsgChannelOpenTry. They are given in hex with --app-hash and
+--data-hash, e.g. from 'gaiad q block [height]'.
 
-	cmd.Flags().StringP(flagBech32Prefix, "p", "cosmos", "Bech32 Prefix to encode to")
+Example:
+	gaiad debug bech32-convert ica connection-0 osmo1... --app-hash 0A1B... --data-hash 2C3D...
+	`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			bech32prefix, err := cmd.Flags().GetString(flagBech32Prefix)
+			if err != nil {
+				return err
+			}
+			appHash, err := getHexFlag(cmd, flagAppHash)
+			if err != nil {
+				return err
+			}
+			dataHash, err := getHexFlag(cmd, flagDataHash)
+			if err != nil {
+				return err
+			}
+
+			address, err := addressutil.ICAHostAddress(args[0], args[1], appHash, dataHash, bech32prefix)
+			if err != nil {
+				return err
+			}
+
+			return printBech32Results(cmd, []bech32Result{{Input: strings.Join(args, "/"), Address: address}}, false, false)
+		},
+	}
+
+	cmd.Flags().String(flagAppHash, "", "Hex app hash of the header of the block in which the channel was opened")
+	cmd.Flags().String(flagDataHash, "", "Hex data hash of the header of the block in which the channel was opened")
 
 	return cmd
 }
 
+func bech32EscrowCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "escrow [port-id] [channel-id]",
+		Short: "Derive the address of the IBC transfer escrow account of a channel",
+		Long: `Derive the address of the IBC transfer escrow account of a channel
+
+Example:
+	gaiad debug bech32-convert escrow transfer channel-0
+	`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			bech32prefix, err := cmd.Flags().GetString(flagBech32Prefix)
+			if err != nil {
+				return err
+			}
+
+			address, err := addressutil.EscrowAddress(args[0], args[1], bech32prefix)
+			if err != nil {
+				return err
+			}
+
+			return printBech32Results(cmd, []bech32Result{{Input: strings.Join(args, "/"), Address: address}}, false, false)
+		},
+	}
+}
+
+func newBech32Result(input, address string, err error) bech32Result {
+	if err != nil {
+		return bech32Result{Input: input, Error: err.Error()}
+	}
+	return bech32Result{Input: input, Address: address}
+}
+
+// printBech32Results prints the results in the output format of the command:
+// a JSON array if list is set, whatever the number of results, or the single
+// result otherwise, and with their input in text output if withInput is set.
+// It fails if any result holds an error, once all of them are printed.
+func printBech32Results(cmd *cobra.Command, results []bech32Result, list, withInput bool) error {
+	output, err := cmd.Flags().GetString(flags.FlagOutput)
+	if err != nil {
+		return err
+	}
+
+	// the results are printed to stdout to be piped
+	out := cmd.OutOrStdout()
+	switch output {
+	case "json":
+		var bz []byte
+		if list {
+			bz, err = json.MarshalIndent(results, "", "  ")
+		} else {
+			bz, err = json.MarshalIndent(results[0], "", "  ")
+		}
+		if err != nil {
+			return err
+		}
+		fmt.Fprintln(out, string(bz))
+	case "text":
+		for _, result := range results {
+			switch {
+			case result.Error != "":
+				fmt.Fprintf(out, "%s error: %s\n", result.Input, result.Error)
+			case withInput:
+				fmt.Fprintf(out, "%s %s\n", result.Input, result.Address)
+			default:
+				fmt.Fprintln(out, result.Address)
+			}
+		}
+	default:
+		return fmt.Errorf("unknown output %q, expected text or json", output)
+	}
+
+	failed := 0
+	for _, result := range results {
+		if result.Error != "" {
+			failed++
+		}
+	}
+	if failed > 0 {
+		cmd.SilenceUsage = true
+		return fmt.Errorf("%d of %d addresses failed", failed, len(results))
+	}
+
+	return nil
+}
+
+// readBech32Input reads the addresses of the first column of the CSV file,
+// or of stdin if file is '-'.
+func readBech32Input(stdin io.Reader, file string) ([]string, error) {
+	r := stdin
+	if file != "-" {
+		f, err := os.Open(file)
+		if err != nil {
+			return nil, err
+		}
+		defer f.Close()
+		r = f
+	}
+
+	reader := csv.NewReader(r)
+	reader.Comment = '#'
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", file, err)
+	}
+
+	addresses := make([]string, 0, len(records))
+	for i, record := range records {
+		address := strings.TrimSpace(record[0])
+		if address == "" || (i == 0 && strings.EqualFold(address, "address")) {
+			continue
+		}
+		addresses = append(addresses, address)
+	}
+
+	return addresses, nil
+}
+
+func getHexFlag(cmd *cobra.Command, name string) ([]byte, error) {
+	value, err := cmd.Flags().GetString(name)
+	if err != nil {
+		return nil, err
+	}
+	bz, err := hex.DecodeString(value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid --%s: %w", name, err)
+	}
+
+	return bz, nil
+}
+
 // addDebugCommands injects custom debug commands into another command as children.
-func addDebugCommands(cmd *cobra.Command) *cobra.Command {
+func addDebugCommands(cmd *cobra.Command, encodingConfig params.EncodingConfig) *cobra.Command {
 	cmd.AddCommand(AddBech32ConvertCommand())
+	cmd.AddCommand(AddressInfoCommand())
+	cmd.AddCommand(UpgradeDryRunCommand(encodingConfig))
+	cmd.AddCommand(AnteDecoratorsCommand(encodingConfig))
+	cmd.AddCommand(GenesisDiffCommand(encodingConfig))
 	return cmd
 }
@@ -0,0 +1,49 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadBech32Input(t *testing.T) {
+	addresses, err := readBech32Input(strings.NewReader(`address,label
+cosmos1a6zlyvpnksx8wr6wz8wemur2xe8zyh0yxeh27a,first
+# a comment
+
+ akash1a6zlyvpnksx8wr6wz8wemur2xe8zyh0ytz6d88
+`), "-")
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		"cosmos1a6zlyvpnksx8wr6wz8wemur2xe8zyh0yxeh27a",
+		"akash1a6zlyvpnksx8wr6wz8wemur2xe8zyh0ytz6d88",
+	}, addresses)
+}
+
+func TestBech32ConvertJSONOutput(t *testing.T) {
+	run := func(stdin string, args ...string) string {
+		cmd := AddBech32ConvertCommand()
+		var out bytes.Buffer
+		cmd.SetOut(&out)
+		cmd.SetIn(strings.NewReader(stdin))
+		cmd.SetArgs(append(args, "--output", "json"))
+		require.NoError(t, cmd.Execute())
+		return out.String()
+	}
+
+	// the commands taking a single address print an object
+	var result bech32Result
+	require.NoError(t, json.Unmarshal([]byte(run("", "akash1a6zlyvpnksx8wr6wz8wemur2xe8zyh0ytz6d88")), &result))
+	require.Equal(t, "cosmos1a6zlyvpnksx8wr6wz8wemur2xe8zyh0yxeh27a", result.Address)
+
+	// the list commands print an array, even with a single address
+	var results []bech32Result
+	require.NoError(t, json.Unmarshal([]byte(run("akash1a6zlyvpnksx8wr6wz8wemur2xe8zyh0ytz6d88\n", "--input", "-")), &results))
+	require.Equal(t, []bech32Result{{Input: "akash1a6zlyvpnksx8wr6wz8wemur2xe8zyh0ytz6d88", Address: "cosmos1a6zlyvpnksx8wr6wz8wemur2xe8zyh0yxeh27a"}}, results)
+	require.NoError(t, json.Unmarshal([]byte(run("", "module", "transfer")), &results))
+	require.Len(t, results, 1)
+	require.Equal(t, "transfer", results[0].Input)
+}
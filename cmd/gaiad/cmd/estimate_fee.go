@@ -0,0 +1,258 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/grpc/node"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/cosmos/gaia/v17/x/globalfee/ante"
+	globalfeetypes "github.com/cosmos/gaia/v17/x/globalfee/types"
+)
+
+// feeEstimate is the fee a tx must pay to be accepted by a node.
+type feeEstimate struct {
+	GasUsed  uint64 `json:"gas_used"`
+	GasLimit uint64 `json:"gas_limit"`
+	// Fees are the fees the tx can pay, one of them is enough. A zero fee
+	// means that the tx needs no fee.
+	Fees   []string `json:"fees"`
+	Bypass bool     `json:"bypass"`
+	// BypassReason tells why the tx does not qualify for the bypass of the
+	// minimum fees, if it only has bypass msgs.
+	BypassReason string `json:"bypass_reason,omitempty"`
+}
+
+// EstimateFeeCommand returns the estimate-fee cobra Command.
+func EstimateFeeCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "estimate-fee [unsigned-tx.json]",
+		Short: "Estimate the gas and the fee a transaction must pay to be accepted",
+		Long: `Estimate the gas and the fee a transaction must pay to be accepted by a node.
+
+The transaction, e.g. generated with --generate-only ('-' reads from stdin), is
+simulated by the node for its gas usage, and the gas limit is the gas usage
+times the gas adjustment. The fee is required by both the global fee params
+and the minimum gas prices of the node, which can be replaced by
+--minimum-gas-prices. A fee in any of the printed denoms is enough.
+
+A transaction made of bypass msgs only, within the bypass gas limit, qualifies
+for the bypass of the minimum fees and needs no fee.
+
+Example:
+	gaiad tx bank send cosmos1... cosmos1... 1000uatom --generate-only > tx.json
+	gaiad tx estimate-fee tx.json --node https://rpc.cosmos.network:443
+	`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			gasAdjustment, err := cmd.Flags().GetFloat64(flags.FlagGasAdjustment)
+			if err != nil {
+				return err
+			}
+			if gasAdjustment < 1 {
+				return fmt.Errorf("gas adjustment must be at least 1, got %v", gasAdjustment)
+			}
+
+			tx, err := authclient.ReadTxFromFile(clientCtx, args[0])
+			if err != nil {
+				return err
+			}
+			gasUsed, err := simulateUnsignedTx(cmd, clientCtx, tx)
+			if err != nil {
+				return fmt.Errorf("failed to simulate the tx: %w", err)
+			}
+
+			globalfeeParams, err := globalfeetypes.NewQueryClient(clientCtx).Params(cmd.Context(), &globalfeetypes.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+			stakingParams, err := stakingtypes.NewQueryClient(clientCtx).Params(cmd.Context(), &stakingtypes.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+			minGasPrices, err := getMinGasPrices(cmd, clientCtx)
+			if err != nil {
+				return err
+			}
+
+			estimate, err := estimateFee(
+				tx.GetMsgs(),
+				uint64(math.Ceil(float64(gasUsed)*gasAdjustment)),
+				globalfeeParams.Params,
+				stakingParams.Params.BondDenom,
+				minGasPrices,
+			)
+			if err != nil {
+				return err
+			}
+			estimate.GasUsed = gasUsed
+
+			out := cmd.OutOrStdout()
+			output, _ := cmd.Flags().GetString(flags.FlagOutput)
+			switch output {
+			case "json":
+				bz, err := json.MarshalIndent(estimate, "", "  ")
+				if err != nil {
+					return err
+				}
+				fmt.Fprintln(out, string(bz))
+			case "text":
+				fmt.Fprintf(out, "Gas used: %d\n", estimate.GasUsed)
+				fmt.Fprintf(out, "Gas limit: %d\n", estimate.GasLimit)
+				switch {
+				case estimate.Bypass:
+					fmt.Fprintln(out, "Bypass: the tx qualifies for the bypass of the minimum fees, no fee is required")
+				case estimate.BypassReason != "":
+					fmt.Fprintf(out, "Bypass: the tx does not qualify for the bypass of the minimum fees: %s\n", estimate.BypassReason)
+				}
+				fmt.Fprintln(out, "Fees, any of:")
+				for _, fee := range estimate.Fees {
+					fmt.Fprintf(out, "  --gas %d --fees %s\n", estimate.GasLimit, fee)
+				}
+			default:
+				return fmt.Errorf("unknown output %q, expected text or json", output)
+			}
+
+			return nil
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	cmd.Flags().Float64(flags.FlagGasAdjustment, 1.3, "Factor the simulated gas usage is multiplied by to get the gas limit")
+	cmd.Flags().String(server.FlagMinGasPrices, "", "Minimum gas prices to use instead of the ones of the node (e.g. 0.005uatom)")
+
+	return cmd
+}
+
+// estimateFee returns the fees required for the msgs and the gas limit by
+// the globalfee params and the minimum gas prices of a node, as checked by
+// the globalfee FeeDecorator in CheckTx.
+func estimateFee(
+	msgs []sdk.Msg,
+	gasLimit uint64,
+	params globalfeetypes.Params,
+	bondDenom string,
+	minGasPrices sdk.DecCoins,
+) (feeEstimate, error) {
+	estimate := feeEstimate{GasLimit: gasLimit}
+
+	if ante.ContainsOnlyMsgTypes(msgs, params.BypassMinFeeMsgTypes) {
+		if gasLimit <= params.MaxTotalBypassMinFeeMsgGasUsage {
+			estimate.Bypass = true
+		} else {
+			estimate.BypassReason = fmt.Sprintf(
+				"the gas limit %d exceeds the maximum bypass gas usage %d",
+				gasLimit, params.MaxTotalBypassMinFeeMsgGasUsage,
+			)
+		}
+	}
+
+	globalMinGasPrices := params.MinimumGasPrices
+	if len(globalMinGasPrices) == 0 {
+		globalMinGasPrices = sdk.DecCoins{sdk.NewDecCoinFromDec(bondDenom, sdk.ZeroDec())}
+	}
+	var localFees sdk.Coins
+	if !minGasPrices.IsZero() {
+		localFees = ante.GetFeesForGas(minGasPrices, gasLimit)
+	}
+	feeRequired, err := ante.CombinedFeeRequirement(ante.GetFeesForGas(globalMinGasPrices, gasLimit), localFees)
+	if err != nil {
+		return feeEstimate{}, err
+	}
+
+	for _, fee := range feeRequired {
+		if estimate.Bypass {
+			fee.Amount = sdk.ZeroInt()
+		}
+		estimate.Fees = append(estimate.Fees, fee.String())
+	}
+
+	return estimate, nil
+}
+
+// simulateUnsignedTx simulates the tx with an empty signature of each signer
+// and returns its gas usage.
+func simulateUnsignedTx(cmd *cobra.Command, clientCtx client.Context, tx sdk.Tx) (uint64, error) {
+	txBuilder, err := clientCtx.TxConfig.WrapTxBuilder(tx)
+	if err != nil {
+		return 0, err
+	}
+
+	sigTx, ok := tx.(interface{ GetSigners() []sdk.AccAddress })
+	if !ok {
+		return 0, fmt.Errorf("cannot get the signers of a %T", tx)
+	}
+	signMode := clientCtx.TxConfig.SignModeHandler().DefaultMode()
+	sigs := make([]signing.SignatureV2, 0, len(sigTx.GetSigners()))
+	for _, signer := range sigTx.GetSigners() {
+		account, err := clientCtx.AccountRetriever.GetAccount(clientCtx, signer)
+		if err != nil {
+			return 0, err
+		}
+
+		// the ante handler uses a sentinel key for an account without key
+		var pubKey cryptotypes.PubKey = &secp256k1.PubKey{}
+		if account.GetPubKey() != nil {
+			pubKey = account.GetPubKey()
+		}
+		var sigData signing.SignatureData = &signing.SingleSignatureData{SignMode: signMode}
+		if multisigPubKey, ok := pubKey.(*multisig.LegacyAminoPubKey); ok {
+			multiSigData := &signing.MultiSignatureData{}
+			for i := uint32(0); i < multisigPubKey.Threshold; i++ {
+				multiSigData.Signatures = append(multiSigData.Signatures, &signing.SingleSignatureData{SignMode: signMode})
+			}
+			sigData = multiSigData
+		}
+		sigs = append(sigs, signing.SignatureV2{PubKey: pubKey, Data: sigData, Sequence: account.GetSequence()})
+	}
+	if err := txBuilder.SetSignatures(sigs...); err != nil {
+		return 0, err
+	}
+
+	txBytes, err := clientCtx.TxConfig.TxEncoder()(txBuilder.GetTx())
+	if err != nil {
+		return 0, err
+	}
+	res, err := txtypes.NewServiceClient(clientCtx).Simulate(cmd.Context(), &txtypes.SimulateRequest{TxBytes: txBytes})
+	if err != nil {
+		return 0, err
+	}
+
+	return res.GasInfo.GasUsed, nil
+}
+
+// getMinGasPrices returns the minimum gas prices of the flag, or else of the
+// node.
+func getMinGasPrices(cmd *cobra.Command, clientCtx client.Context) (sdk.DecCoins, error) {
+	minGasPrices, err := cmd.Flags().GetString(server.FlagMinGasPrices)
+	if err != nil {
+		return nil, err
+	}
+	if !cmd.Flags().Changed(server.FlagMinGasPrices) {
+		res, err := node.NewServiceClient(clientCtx).Config(cmd.Context(), &node.ConfigRequest{})
+		if err != nil {
+			return nil, fmt.Errorf("failed to query the minimum gas prices of the node, set --%s: %w", server.FlagMinGasPrices, err)
+		}
+		minGasPrices = res.MinimumGasPrice
+	}
+
+	return sdk.ParseDecCoins(minGasPrices)
+}
@@ -0,0 +1,87 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	ibcclienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	globalfeetypes "github.com/cosmos/gaia/v17/x/globalfee/types"
+)
+
+func TestEstimateFee(t *testing.T) {
+	send := &banktypes.MsgSend{}
+	updateClient := &ibcclienttypes.MsgUpdateClient{}
+	params := globalfeetypes.Params{
+		MinimumGasPrices: sdk.NewDecCoins(
+			sdk.NewDecCoinFromDec("photon", sdk.MustNewDecFromStr("0.1")),
+			sdk.NewDecCoinFromDec("uatom", sdk.MustNewDecFromStr("0.002")),
+		),
+		BypassMinFeeMsgTypes:            []string{sdk.MsgTypeURL(updateClient)},
+		MaxTotalBypassMinFeeMsgGasUsage: 200_000,
+	}
+
+	tests := map[string]struct {
+		msgs         []sdk.Msg
+		gasLimit     uint64
+		params       globalfeetypes.Params
+		minGasPrices sdk.DecCoins
+		fees         []string
+		bypass       bool
+		bypassReason string
+	}{
+		"global fees": {
+			msgs:     []sdk.Msg{send},
+			gasLimit: 100_001,
+			params:   params,
+			fees:     []string{"10001photon", "201uatom"},
+		},
+		"node min gas prices above the global fees": {
+			msgs:         []sdk.Msg{send},
+			gasLimit:     100_000,
+			params:       params,
+			minGasPrices: sdk.NewDecCoins(sdk.NewDecCoinFromDec("uatom", sdk.MustNewDecFromStr("0.005"))),
+			fees:         []string{"10000photon", "500uatom"},
+		},
+		"no global fees": {
+			msgs:     []sdk.Msg{send},
+			gasLimit: 100_000,
+			fees:     []string{"0stake"},
+		},
+		"bypass": {
+			msgs:     []sdk.Msg{updateClient},
+			gasLimit: 100_000,
+			params:   params,
+			fees:     []string{"0photon", "0uatom"},
+			bypass:   true,
+		},
+		"bypass msgs above the bypass gas usage": {
+			msgs:         []sdk.Msg{updateClient},
+			gasLimit:     200_001,
+			params:       params,
+			fees:         []string{"20001photon", "401uatom"},
+			bypassReason: "the gas limit 200001 exceeds the maximum bypass gas usage 200000",
+		},
+		"bypass and other msgs": {
+			msgs:     []sdk.Msg{updateClient, send},
+			gasLimit: 100_000,
+			params:   params,
+			fees:     []string{"10000photon", "200uatom"},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			estimate, err := estimateFee(tc.msgs, tc.gasLimit, tc.params, "stake", tc.minGasPrices)
+			require.NoError(t, err)
+			require.Equal(t, tc.gasLimit, estimate.GasLimit)
+			require.Equal(t, tc.fees, estimate.Fees)
+			require.Equal(t, tc.bypass, estimate.Bypass)
+			require.Equal(t, tc.bypassReason, estimate.BypassReason)
+		})
+	}
+}
@@ -0,0 +1,599 @@
+package cmd
+
+import (
+	"bufio"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/spf13/cobra"
+
+	dbm "github.com/cometbft/cometbft-db"
+	tmjson "github.com/cometbft/cometbft/libs/json"
+	tmtypes "github.com/cometbft/cometbft/types"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+)
+
+const (
+	flagDryRun      = "dry-run"
+	flagStream      = "stream"
+	flagCompression = "compression"
+	flagTraceStore  = "trace-store"
+
+	compressionNone = "none"
+	compressionGzip = "gzip"
+	compressionZstd = "zstd"
+)
+
+// extendExportCmd adds to the export command of the SDK server commands a
+// streaming mode, as the SDK export holds the whole app state in memory, and
+// a dry run of the zero height preparation.
+func extendExportCmd(rootCmd *cobra.Command, ac appCreator) {
+	exportCmd, _, err := rootCmd.Find([]string{"export"})
+	if err != nil {
+		panic(err)
+	}
+
+	runE := exportCmd.RunE
+	exportCmd.RunE = func(cmd *cobra.Command, args []string) error {
+		if dryRun, _ := cmd.Flags().GetBool(flagDryRun); dryRun {
+			return ac.zeroHeightDryRun(cmd)
+		}
+		if stream, _ := cmd.Flags().GetBool(flagStream); stream {
+			return ac.streamExport(cmd)
+		}
+		return runE(cmd, args)
+	}
+	exportCmd.Long = `Export state to JSON.
+
+With --stream, the state is exported module by module to the --output-document
+file, optionally compressed, so that only the state of a single module is held in
+memory. The genesis of each module is first written to the <output>.parts
+directory, along with a manifest holding its SHA-256 checksum. An interrupted
+export run again with the same options resumes from the modules already
+written. Once all modules are exported, they are assembled into the output
+file, the manifest is moved to <output>.manifest.json and the parts are
+removed.
+
+With --dry-run and --for-zero-height, the state is prepared for a chain
+restart at height zero without producing the genesis, and a report of the
+changes is printed instead: validators jailed, commission and rewards
+withdrawn, and heights reset.
+
+Example:
+	gaiad export --stream --output-document genesis.json.zst --compression zstd --height 18000000
+	gaiad export --for-zero-height --jail-allowed-addrs cosmosvaloper1... --dry-run
+`
+
+	exportCmd.Flags().Bool(flagDryRun, false, "Print the changes made by --for-zero-height instead of exporting the state")
+	exportCmd.Flags().Bool(flagStream, false, fmt.Sprintf("Export the state module by module to the --%s file", server.FlagOutputDocument))
+	// the SDK export reads the flag without defining it
+	if exportCmd.Flags().Lookup(flagTraceStore) == nil {
+		exportCmd.Flags().String(flagTraceStore, "", "Enable KVStore tracing to an output file")
+	}
+	exportCmd.Flags().String(flagCompression, compressionNone, fmt.Sprintf("Compression of the --stream output: %s, %s or %s", compressionNone, compressionGzip, compressionZstd))
+}
+
+// zeroHeightDryRun prints the changes made to the state by a zero height export.
+func (a appCreator) zeroHeightDryRun(cmd *cobra.Command) error {
+	serverCtx := server.GetServerContextFromCmd(cmd)
+	config := serverCtx.Config
+
+	homeDir, _ := cmd.Flags().GetString(flags.FlagHome)
+	config.SetRoot(homeDir)
+
+	if forZeroHeight, _ := cmd.Flags().GetBool(server.FlagForZeroHeight); !forZeroHeight {
+		return fmt.Errorf("--%s requires --%s", flagDryRun, server.FlagForZeroHeight)
+	}
+	height, _ := cmd.Flags().GetInt64(server.FlagHeight)
+	jailAllowedAddrs, _ := cmd.Flags().GetStringSlice(server.FlagJailAllowedAddrs)
+
+	db, err := dbm.NewDB("application", server.GetAppDBBackend(serverCtx.Viper), filepath.Join(config.RootDir, "data"))
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	traceWriter, err := openTraceWriter(cmd)
+	if err != nil {
+		return err
+	}
+	if traceWriter != nil {
+		defer traceWriter.Close()
+	}
+
+	gaiaApp, err := a.exportApp(serverCtx.Logger, db, traceWriter, height, serverCtx.Viper)
+	if err != nil {
+		return err
+	}
+
+	report, err := gaiaApp.ZeroHeightDryRun(jailAllowedAddrs)
+	if err != nil {
+		return err
+	}
+
+	out, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return err
+	}
+	cmd.SetOut(cmd.OutOrStdout())
+	cmd.Println(string(out))
+
+	return nil
+}
+
+// streamExport runs the export command in streaming mode.
+func (a appCreator) streamExport(cmd *cobra.Command) error {
+	serverCtx := server.GetServerContextFromCmd(cmd)
+	config := serverCtx.Config
+
+	homeDir, _ := cmd.Flags().GetString(flags.FlagHome)
+	config.SetRoot(homeDir)
+
+	output, _ := cmd.Flags().GetString(server.FlagOutputDocument)
+	if output == "" {
+		return fmt.Errorf("--%s is required with --%s", server.FlagOutputDocument, flagStream)
+	}
+	compression, _ := cmd.Flags().GetString(flagCompression)
+	if err := validateCompression(compression); err != nil {
+		return err
+	}
+	height, _ := cmd.Flags().GetInt64(server.FlagHeight)
+	forZeroHeight, _ := cmd.Flags().GetBool(server.FlagForZeroHeight)
+	jailAllowedAddrs, _ := cmd.Flags().GetStringSlice(server.FlagJailAllowedAddrs)
+	modulesToExport, _ := cmd.Flags().GetStringSlice(server.FlagModulesToExport)
+
+	doc, err := tmtypes.GenesisDocFromFile(config.GenesisFile())
+	if err != nil {
+		return err
+	}
+
+	db, err := dbm.NewDB("application", server.GetAppDBBackend(serverCtx.Viper), filepath.Join(config.RootDir, "data"))
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	traceWriter, err := openTraceWriter(cmd)
+	if err != nil {
+		return err
+	}
+	if traceWriter != nil {
+		defer traceWriter.Close()
+	}
+
+	gaiaApp, err := a.exportApp(serverCtx.Logger, db, traceWriter, height, serverCtx.Viper)
+	if err != nil {
+		return err
+	}
+	modules, err := gaiaApp.ModulesToExport(modulesToExport)
+	if err != nil {
+		return err
+	}
+
+	export, err := openStreamExport(output, exportManifest{
+		Height:           gaiaApp.LastBlockHeight(),
+		ForZeroHeight:    forZeroHeight,
+		JailAllowedAddrs: jailAllowedAddrs,
+		Compression:      compression,
+		Modules:          modules,
+	})
+	if err != nil {
+		return err
+	}
+	if done := len(modules) - len(export.Pending()); done > 0 {
+		cmd.PrintErrf("resuming export, %d of %d modules already exported\n", done, len(modules))
+	}
+
+	exported, err := gaiaApp.ExportAppStateStream(forZeroHeight, jailAllowedAddrs, export.Pending(), func(module string, genesis json.RawMessage) error {
+		cmd.PrintErrf("exporting module %s\n", module)
+		return export.WriteModule(module, genesis)
+	})
+	if err != nil {
+		return fmt.Errorf("error exporting state: %w", err)
+	}
+
+	doc.Validators = exported.Validators
+	doc.InitialHeight = exported.Height
+	doc.ConsensusParams = &tmtypes.ConsensusParams{
+		Block: tmtypes.BlockParams{
+			MaxBytes: exported.ConsensusParams.Block.MaxBytes,
+			MaxGas:   exported.ConsensusParams.Block.MaxGas,
+		},
+		Evidence: tmtypes.EvidenceParams{
+			MaxAgeNumBlocks: exported.ConsensusParams.Evidence.MaxAgeNumBlocks,
+			MaxAgeDuration:  exported.ConsensusParams.Evidence.MaxAgeDuration,
+			MaxBytes:        exported.ConsensusParams.Evidence.MaxBytes,
+		},
+		Validator: tmtypes.ValidatorParams{
+			PubKeyTypes: exported.ConsensusParams.Validator.PubKeyTypes,
+		},
+	}
+
+	return export.Finalize(doc)
+}
+
+// openTraceWriter opens the --trace-store file the KVStore operations are
+// traced to, in append mode as the SDK does. It returns nil if the flag is
+// not set.
+func openTraceWriter(cmd *cobra.Command) (io.WriteCloser, error) {
+	file, _ := cmd.Flags().GetString(flagTraceStore)
+	if file == "" {
+		return nil, nil
+	}
+
+	return os.OpenFile(file, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0o666)
+}
+
+// exportManifest describes a streaming export: the options it runs with and
+// the modules it exports, with the checksum of the genesis of the modules
+// already written.
+type exportManifest struct {
+	Height           int64    `json:"height"`
+	ForZeroHeight    bool     `json:"for_zero_height"`
+	JailAllowedAddrs []string `json:"jail_allowed_addrs"`
+	Compression      string   `json:"compression"`
+	Modules          []string `json:"modules"`
+
+	Exported []exportedModule `json:"exported"`
+	// SHA256 is the checksum of the output file, set once the export is complete
+	SHA256 string `json:"sha256,omitempty"`
+}
+
+// exportedModule is the genesis of a module written by a streaming export.
+type exportedModule struct {
+	Name string `json:"name"`
+	// File is the path of the module file within the parts directory
+	File string `json:"file"`
+	// SHA256 is the checksum of the uncompressed genesis of the module
+	SHA256 string `json:"sha256"`
+	Size   int64  `json:"size"`
+}
+
+// sameExport returns true if both manifests describe the same export.
+func (m exportManifest) sameExport(other exportManifest) bool {
+	return m.Height == other.Height &&
+		m.ForZeroHeight == other.ForZeroHeight &&
+		slices.Equal(m.JailAllowedAddrs, other.JailAllowedAddrs) &&
+		m.Compression == other.Compression &&
+		slices.Equal(m.Modules, other.Modules)
+}
+
+// streamExport writes the genesis of each module to its own file of the parts
+// directory, then assembles them into the output genesis file.
+type streamExport struct {
+	output   string
+	partsDir string
+	manifest exportManifest
+}
+
+// openStreamExport starts the streaming export to the given output file, or
+// resumes it if an export with the same options was interrupted. The module
+// files whose checksum does not match the manifest are exported again.
+func openStreamExport(output string, manifest exportManifest) (*streamExport, error) {
+	if err := validateCompression(manifest.Compression); err != nil {
+		return nil, err
+	}
+
+	export := &streamExport{
+		output:   output,
+		partsDir: output + ".parts",
+		manifest: manifest,
+	}
+
+	bz, err := os.ReadFile(export.manifestPath())
+	switch {
+	case errors.Is(err, os.ErrNotExist):
+		if err := os.MkdirAll(export.partsDir, 0o755); err != nil {
+			return nil, err
+		}
+		return export, export.saveManifest()
+	case err != nil:
+		return nil, err
+	}
+
+	var previous exportManifest
+	if err := json.Unmarshal(bz, &previous); err != nil {
+		return nil, fmt.Errorf("invalid manifest %s: %w", export.manifestPath(), err)
+	}
+	if !previous.sameExport(manifest) {
+		return nil, fmt.Errorf("%s holds an export with other options, remove it to start over", export.partsDir)
+	}
+
+	for _, module := range previous.Exported {
+		if err := export.verifyModule(module); err != nil {
+			// the module is exported again
+			continue
+		}
+		export.manifest.Exported = append(export.manifest.Exported, module)
+	}
+
+	return export, export.saveManifest()
+}
+
+// Pending returns the modules that are not exported yet, in export order.
+func (e *streamExport) Pending() []string {
+	var pending []string
+	for _, name := range e.manifest.Modules {
+		if _, ok := e.exported(name); !ok {
+			pending = append(pending, name)
+		}
+	}
+
+	return pending
+}
+
+// WriteModule writes the genesis of the module to its file and records its
+// checksum in the manifest.
+func (e *streamExport) WriteModule(name string, genesis json.RawMessage) error {
+	if !slices.Contains(e.manifest.Modules, name) {
+		return fmt.Errorf("module %s is not part of the export", name)
+	}
+	if genesis == nil {
+		genesis = json.RawMessage("null")
+	}
+
+	module := exportedModule{
+		Name: name,
+		File: name + ".json" + compressionExt(e.manifest.Compression),
+		Size: int64(len(genesis)),
+	}
+	checksum := sha256.Sum256(genesis)
+	module.SHA256 = hex.EncodeToString(checksum[:])
+
+	if err := writeFileAtomic(filepath.Join(e.partsDir, module.File), func(w io.Writer) error {
+		cw, err := compressWriter(w, e.manifest.Compression)
+		if err != nil {
+			return err
+		}
+		if _, err := cw.Write(genesis); err != nil {
+			return err
+		}
+		return cw.Close()
+	}); err != nil {
+		return err
+	}
+
+	e.manifest.Exported = slices.DeleteFunc(e.manifest.Exported, func(m exportedModule) bool {
+		return m.Name == name
+	})
+	e.manifest.Exported = append(e.manifest.Exported, module)
+
+	return e.saveManifest()
+}
+
+// Finalize assembles the genesis of all the modules into the app state of the
+// given genesis document and writes it to the output file. The manifest is
+// kept next to the output and the parts directory is removed.
+func (e *streamExport) Finalize(doc *tmtypes.GenesisDoc) error {
+	if pending := e.Pending(); len(pending) > 0 {
+		return fmt.Errorf("modules %v are not exported yet", pending)
+	}
+
+	doc.AppState = nil
+	// the document is encoded without app state, which is omitted when empty,
+	// and its closing brace is replaced by the streamed app state
+	header, err := tmjson.Marshal(doc)
+	if err != nil {
+		return err
+	}
+
+	checksum := sha256.New()
+	if err := writeFileAtomic(e.output, func(w io.Writer) error {
+		cw, err := compressWriter(io.MultiWriter(w, checksum), e.manifest.Compression)
+		if err != nil {
+			return err
+		}
+		if err := e.writeGenesis(cw, header); err != nil {
+			return err
+		}
+		return cw.Close()
+	}); err != nil {
+		return err
+	}
+	e.manifest.SHA256 = hex.EncodeToString(checksum.Sum(nil))
+
+	bz, err := json.MarshalIndent(e.manifest, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := writeFileAtomic(e.output+".manifest.json", func(w io.Writer) error {
+		_, err := w.Write(bz)
+		return err
+	}); err != nil {
+		return err
+	}
+
+	return os.RemoveAll(e.partsDir)
+}
+
+func (e *streamExport) writeGenesis(w io.Writer, header []byte) error {
+	if _, err := w.Write(header[:len(header)-1]); err != nil {
+		return err
+	}
+	if _, err := io.WriteString(w, `,"app_state":{`); err != nil {
+		return err
+	}
+
+	for i, name := range e.manifest.Modules {
+		module, _ := e.exported(name)
+
+		key, err := json.Marshal(name)
+		if err != nil {
+			return err
+		}
+		if i > 0 {
+			key = append([]byte{','}, key...)
+		}
+		if _, err := w.Write(append(key, ':')); err != nil {
+			return err
+		}
+
+		if err := e.readModule(module, func(r io.Reader) error {
+			_, err := io.Copy(w, r)
+			return err
+		}); err != nil {
+			return err
+		}
+	}
+
+	_, err := io.WriteString(w, "}}")
+	return err
+}
+
+// verifyModule checks that the file of the module matches its checksum.
+func (e *streamExport) verifyModule(module exportedModule) error {
+	checksum := sha256.New()
+	if err := e.readModule(module, func(r io.Reader) error {
+		_, err := io.Copy(checksum, r)
+		return err
+	}); err != nil {
+		return err
+	}
+
+	if hex.EncodeToString(checksum.Sum(nil)) != module.SHA256 {
+		return fmt.Errorf("checksum mismatch of %s", module.File)
+	}
+
+	return nil
+}
+
+// readModule passes the uncompressed genesis of the module to read.
+func (e *streamExport) readModule(module exportedModule, read func(io.Reader) error) error {
+	f, err := os.Open(filepath.Join(e.partsDir, module.File))
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	r, err := decompressReader(bufio.NewReader(f), e.manifest.Compression)
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+
+	return read(r)
+}
+
+func (e *streamExport) exported(name string) (exportedModule, bool) {
+	for _, module := range e.manifest.Exported {
+		if module.Name == name {
+			return module, true
+		}
+	}
+
+	return exportedModule{}, false
+}
+
+func (e *streamExport) manifestPath() string {
+	return filepath.Join(e.partsDir, "manifest.json")
+}
+
+func (e *streamExport) saveManifest() error {
+	bz, err := json.MarshalIndent(e.manifest, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return writeFileAtomic(e.manifestPath(), func(w io.Writer) error {
+		_, err := w.Write(bz)
+		return err
+	})
+}
+
+// writeFileAtomic writes the file through a temporary file renamed once
+// fully written and synced, so that an interrupted write leaves no partial
+// file behind.
+func writeFileAtomic(path string, write func(io.Writer) error) error {
+	tmp := path + ".tmp"
+	f, err := os.Create(tmp)
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp)
+
+	bw := bufio.NewWriter(f)
+	if err := write(bw); err != nil {
+		f.Close()
+		return err
+	}
+	if err := bw.Flush(); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Sync(); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp, path)
+}
+
+func validateCompression(compression string) error {
+	switch compression {
+	case compressionNone, compressionGzip, compressionZstd:
+		return nil
+	default:
+		return fmt.Errorf("unknown compression %q, expected %s, %s or %s", compression, compressionNone, compressionGzip, compressionZstd)
+	}
+}
+
+func compressionExt(compression string) string {
+	switch compression {
+	case compressionGzip:
+		return ".gz"
+	case compressionZstd:
+		return ".zst"
+	default:
+		return ""
+	}
+}
+
+type nopWriteCloser struct {
+	io.Writer
+}
+
+func (nopWriteCloser) Close() error { return nil }
+
+func compressWriter(w io.Writer, compression string) (io.WriteCloser, error) {
+	switch compression {
+	case compressionNone:
+		return nopWriteCloser{w}, nil
+	case compressionGzip:
+		return gzip.NewWriter(w), nil
+	case compressionZstd:
+		return zstd.NewWriter(w)
+	default:
+		return nil, validateCompression(compression)
+	}
+}
+
+func decompressReader(r io.Reader, compression string) (io.ReadCloser, error) {
+	switch compression {
+	case compressionNone:
+		return io.NopCloser(r), nil
+	case compressionGzip:
+		return gzip.NewReader(r)
+	case compressionZstd:
+		d, err := zstd.NewReader(r)
+		if err != nil {
+			return nil, err
+		}
+		return d.IOReadCloser(), nil
+	default:
+		return nil, validateCompression(compression)
+	}
+}
@@ -0,0 +1,86 @@
+package cmd
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	tmtypes "github.com/cometbft/cometbft/types"
+)
+
+func TestStreamExport(t *testing.T) {
+	for _, compression := range []string{compressionNone, compressionGzip, compressionZstd} {
+		t.Run(compression, func(t *testing.T) {
+			output := filepath.Join(t.TempDir(), "genesis.json")
+			manifest := exportManifest{
+				Height:      10,
+				Compression: compression,
+				Modules:     []string{"auth", "bank", "staking"},
+			}
+
+			export, err := openStreamExport(output, manifest)
+			require.NoError(t, err)
+			require.Equal(t, []string{"auth", "bank", "staking"}, export.Pending())
+			require.NoError(t, export.WriteModule("auth", json.RawMessage(`{"accounts":[]}`)))
+			require.NoError(t, export.WriteModule("bank", json.RawMessage(`{"balances":[]}`)))
+			require.Error(t, export.WriteModule("gov", json.RawMessage(`{}`)))
+
+			// the export is interrupted, a module file gets corrupted
+			bank := filepath.Join(output+".parts", "bank.json"+compressionExt(compression))
+			require.NoError(t, os.WriteFile(bank, []byte("corrupted"), 0o600))
+
+			// resuming with other options fails
+			other := manifest
+			other.Height = 11
+			_, err = openStreamExport(output, other)
+			require.ErrorContains(t, err, "holds an export with other options")
+
+			export, err = openStreamExport(output, manifest)
+			require.NoError(t, err)
+			require.Equal(t, []string{"bank", "staking"}, export.Pending())
+			require.ErrorContains(t, export.Finalize(&tmtypes.GenesisDoc{ChainID: "gaia-test"}), "are not exported yet")
+
+			require.NoError(t, export.WriteModule("bank", json.RawMessage(`{"balances":[{"address":"a"}]}`)))
+			require.NoError(t, export.WriteModule("staking", nil))
+			require.NoError(t, export.Finalize(&tmtypes.GenesisDoc{ChainID: "gaia-test", InitialHeight: 11}))
+
+			require.NoDirExists(t, output+".parts")
+			require.FileExists(t, output+".manifest.json")
+
+			f, err := os.Open(output)
+			require.NoError(t, err)
+			defer f.Close()
+			r, err := decompressReader(bufio.NewReader(f), compression)
+			require.NoError(t, err)
+			defer r.Close()
+			bz, err := io.ReadAll(r)
+			require.NoError(t, err)
+
+			var genesis struct {
+				ChainID       string                     `json:"chain_id"`
+				InitialHeight string                     `json:"initial_height"`
+				AppState      map[string]json.RawMessage `json:"app_state"`
+			}
+			require.NoError(t, json.Unmarshal(bz, &genesis))
+			require.Equal(t, "gaia-test", genesis.ChainID)
+			require.Equal(t, "11", genesis.InitialHeight)
+			require.Equal(t, map[string]json.RawMessage{
+				"auth":    json.RawMessage(`{"accounts":[]}`),
+				"bank":    json.RawMessage(`{"balances":[{"address":"a"}]}`),
+				"staking": json.RawMessage(`null`),
+			}, genesis.AppState)
+
+			bz, err = os.ReadFile(output + ".manifest.json")
+			require.NoError(t, err)
+			var final exportManifest
+			require.NoError(t, json.Unmarshal(bz, &final))
+			require.Len(t, final.Exported, 3)
+			require.NotEmpty(t, final.SHA256)
+		})
+	}
+}
@@ -2,28 +2,40 @@ package cmd
 
 import (
 	"bufio"
+	"encoding/csv"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
 
 	"github.com/spf13/cobra"
 
+	tmtypes "github.com/cometbft/cometbft/types"
+
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
-	"github.com/cosmos/cosmos-sdk/server"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingcli "github.com/cosmos/cosmos-sdk/x/auth/vesting/client/cli"
+	vestingexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
 	authvesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
-	"github.com/cosmos/cosmos-sdk/x/genutil"
-	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
 )
 
 const (
-	flagVestingStart = "vesting-start-time"
-	flagVestingEnd   = "vesting-end-time"
-	flagVestingAmt   = "vesting-amount"
+	flagVestingStart       = "vesting-start-time"
+	flagVestingEnd         = "vesting-end-time"
+	flagVestingAmt         = "vesting-amount"
+	flagVestingPeriodsFile = "vesting-periods-file"
+	flagPermanentLocked    = "permanent-locked"
+	flagAppendMode         = "append"
+	flagExpectedSupply     = "expected-supply"
 )
 
 // AddGenesisAccountCmd returns add-genesis-account cobra Command.
@@ -34,15 +46,17 @@ func AddGenesisAccountCmd(defaultNodeHome string) *cobra.Command {
 		Long: `Add a genesis account to genesis.json. The provided account must specify
 the account address or key name and a list of initial coins. If a key name is given,
 the address will be looked up in the local Keybase. The list of initial tokens must
-contain valid denominations. Accounts may optionally be supplied with vesting parameters.
+contain valid denominations. Accounts may optionally be supplied with vesting parameters:
+  - a continuous vesting with --vesting-amount, --vesting-start-time and --vesting-end-time
+  - a delayed vesting with --vesting-amount and --vesting-end-time
+  - a periodic vesting with --vesting-periods-file, a JSON file such as
+    {"start_time": 1625204910, "periods": [{"coins": "10uatom", "length_seconds": 2592000}]}
+  - a permanently locked amount with --permanent-locked, all the coins unless
+    --vesting-amount is given
 `,
 		Args: cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx := client.GetClientContextFromCmd(cmd)
-			serverCtx := server.GetServerContextFromCmd(cmd)
-			config := serverCtx.Config
-
-			config.SetRoot(clientCtx.HomeDir)
 
 			var kr keyring.Keyring
 			addr, err := sdk.AccAddressFromBech32(args[0])
@@ -79,11 +93,12 @@ contain valid denominations. Accounts may optionally be supplied with vesting pa
 				return fmt.Errorf("failed to parse coins: %w", err)
 			}
 
-			vestingStart, err := cmd.Flags().GetInt64(flagVestingStart)
+			var vesting vestingParams
+			vesting.start, err = cmd.Flags().GetInt64(flagVestingStart)
 			if err != nil {
 				return err
 			}
-			vestingEnd, err := cmd.Flags().GetInt64(flagVestingEnd)
+			vesting.end, err = cmd.Flags().GetInt64(flagVestingEnd)
 			if err != nil {
 				return err
 			}
@@ -91,107 +106,452 @@ contain valid denominations. Accounts may optionally be supplied with vesting pa
 			if err != nil {
 				return err
 			}
-
-			vestingAmt, err := sdk.ParseCoinsNormalized(vestingAmtStr)
+			vesting.amount, err = sdk.ParseCoinsNormalized(vestingAmtStr)
 			if err != nil {
 				return fmt.Errorf("failed to parse vesting amount: %w", err)
 			}
-
-			// create concrete account type based on input parameters
-			var genAccount authtypes.GenesisAccount
-
-			balances := banktypes.Balance{Address: addr.String(), Coins: coins.Sort()}
-			baseAccount := authtypes.NewBaseAccount(addr, nil, 0, 0)
-
-			if !vestingAmt.IsZero() {
-				baseVestingAccount := authvesting.NewBaseVestingAccount(baseAccount, vestingAmt.Sort(), vestingEnd)
-
-				if (balances.Coins.IsZero() && !baseVestingAccount.OriginalVesting.IsZero()) ||
-					baseVestingAccount.OriginalVesting.IsAnyGT(balances.Coins) {
-					return errors.New("vesting amount cannot be greater than total amount")
+			vesting.permanentLocked, err = cmd.Flags().GetBool(flagPermanentLocked)
+			if err != nil {
+				return err
+			}
+			periodsFile, err := cmd.Flags().GetString(flagVestingPeriodsFile)
+			if err != nil {
+				return err
+			}
+			if periodsFile != "" {
+				if vesting.start != 0 {
+					return fmt.Errorf("the start time of a periodic vesting is given by the periods file, not by --%s", flagVestingStart)
 				}
-
-				switch {
-				case vestingStart != 0 && vestingEnd != 0:
-					genAccount = authvesting.NewContinuousVestingAccountRaw(baseVestingAccount, vestingStart)
-
-				case vestingEnd != 0:
-					genAccount = authvesting.NewDelayedVestingAccountRaw(baseVestingAccount)
-
-				default:
-					return errors.New("invalid vesting parameters; must supply start and end time or end time")
+				vesting.start, vesting.periods, err = readVestingPeriods(periodsFile)
+				if err != nil {
+					return err
 				}
-			} else {
-				genAccount = baseAccount
 			}
 
-			if err := genAccount.Validate(); err != nil {
-				return fmt.Errorf("failed to validate new genesis account: %w", err)
+			genAccount, err := newGenesisAccount(addr, coins, vesting)
+			if err != nil {
+				return err
 			}
-
-			genFile := config.GenesisFile()
-			appState, genDoc, err := genutiltypes.GenesisStateFromGenFile(genFile)
+			appendCoins, err := cmd.Flags().GetBool(flagAppendMode)
 			if err != nil {
-				return fmt.Errorf("failed to unmarshal genesis state: %w", err)
+				return err
 			}
 
-			authGenState := authtypes.GetGenesisStateFromAppState(clientCtx.Codec, appState)
+			return modifyGenesis(cmd, func(cdc codec.Codec, appState map[string]json.RawMessage, _ *tmtypes.GenesisDoc) error {
+				return addGenesisAccounts(cdc, appState, []genesisAccount{genAccount}, appendCoins)
+			})
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	cmd.Flags().String(flags.FlagKeyringBackend, flags.DefaultKeyringBackend, "Select keyring's backend (os|file|kwallet|pass|test)")
+	cmd.Flags().String(flagVestingAmt, "", "amount of coins for vesting accounts")
+	cmd.Flags().Int64(flagVestingStart, 0, "schedule start time (unix epoch) for vesting accounts")
+	cmd.Flags().Int64(flagVestingEnd, 0, "schedule end time (unix epoch) for vesting accounts")
+	cmd.Flags().String(flagVestingPeriodsFile, "", "JSON file of the start time and periods of a periodic vesting account")
+	cmd.Flags().Bool(flagPermanentLocked, false, "lock the vesting amount, or all the coins, permanently")
+	cmd.Flags().Bool(flagAppendMode, false, "append the coins to an account already in the genesis.json file")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
 
-			accs, err := authtypes.UnpackAccounts(authGenState.Accounts)
+// BulkAddGenesisAccountCmd returns bulk-add-genesis-account cobra Command.
+func BulkAddGenesisAccountCmd(defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bulk-add-genesis-account [accounts_file]",
+		Short: "Add the genesis accounts of a JSON or CSV file to genesis.json",
+		Long: `Add the genesis accounts of a JSON or CSV file, e.g. an allocation sheet, to
+genesis.json. Each account has an address, coins and optionally the vesting
+parameters of add-genesis-account.
+
+A JSON file holds a list of accounts:
+[
+  {"address": "cosmos1...", "coins": "100uatom"},
+  {"address": "cosmos1...", "coins": "100uatom", "vesting_amount": "50uatom", "vesting_end_time": 1700000000},
+  {"address": "cosmos1...", "coins": "100uatom", "vesting_start_time": 1700000000,
+   "vesting_periods": [{"coins": "50uatom", "length_seconds": 2592000}, {"coins": "50uatom", "length_seconds": 2592000}]},
+  {"address": "cosmos1...", "coins": "100uatom", "permanent_locked": true}
+]
+
+A CSV file has a header naming its columns, among address, coins, vesting_amount,
+vesting_start_time, vesting_end_time, vesting_periods and permanent_locked. The
+vesting periods are written as length_seconds:coins separated by semicolons:
+address,coins,vesting_start_time,vesting_periods
+cosmos1...,100uatom,1700000000,2592000:50uatom;2592000:50uatom
+
+With --expected-supply, the accounts are only added if the total supply of the
+resulting genesis is the expected one.
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			entries, err := readBulkGenesisAccounts(args[0])
 			if err != nil {
-				return fmt.Errorf("failed to get accounts from any: %w", err)
+				return err
 			}
 
-			if accs.Contains(addr) {
-				return fmt.Errorf("cannot add account at existing address %s", addr)
+			accounts := make([]genesisAccount, 0, len(entries))
+			for i, entry := range entries {
+				account, err := entry.genesisAccount()
+				if err != nil {
+					return fmt.Errorf("invalid account %d (%s): %w", i+1, entry.Address, err)
+				}
+				accounts = append(accounts, account)
 			}
 
-			// Add the new account to the set of genesis accounts and sanitize the
-			// accounts afterwards.
-			accs = append(accs, genAccount)
-			accs = authtypes.SanitizeGenesisAccounts(accs)
-
-			genAccs, err := authtypes.PackAccounts(accs)
+			expectedSupplyStr, err := cmd.Flags().GetString(flagExpectedSupply)
 			if err != nil {
-				return fmt.Errorf("failed to convert accounts into any's: %w", err)
+				return err
 			}
-			authGenState.Accounts = genAccs
-
-			authGenStateBz, err := clientCtx.Codec.MarshalJSON(&authGenState)
+			expectedSupply, err := sdk.ParseCoinsNormalized(expectedSupplyStr)
 			if err != nil {
-				return fmt.Errorf("failed to marshal auth genesis state: %w", err)
+				return fmt.Errorf("failed to parse expected supply: %w", err)
 			}
 
-			appState[authtypes.ModuleName] = authGenStateBz
+			return modifyGenesis(cmd, func(cdc codec.Codec, appState map[string]json.RawMessage, _ *tmtypes.GenesisDoc) error {
+				if err := addGenesisAccounts(cdc, appState, accounts, false); err != nil {
+					return err
+				}
+
+				supply := banktypes.GetGenesisStateFromAppState(cdc, appState).Supply
+				if expectedSupplyStr != "" && supply.String() != expectedSupply.String() {
+					return fmt.Errorf("total supply %s does not match the expected supply %s", supply, expectedSupply)
+				}
+				cmd.Printf("Added %d accounts, total supply is %s\n", len(accounts), supply)
 
-			bankGenState := banktypes.GetGenesisStateFromAppState(clientCtx.Codec, appState)
-			bankGenState.Balances = append(bankGenState.Balances, balances)
-			bankGenState.Balances = banktypes.SanitizeGenesisBalances(bankGenState.Balances)
-			bankGenState.Supply = bankGenState.Supply.Add(balances.Coins...)
+				return nil
+			})
+		},
+	}
 
-			bankGenStateBz, err := clientCtx.Codec.MarshalJSON(bankGenState)
-			if err != nil {
-				return fmt.Errorf("failed to marshal bank genesis state: %w", err)
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	cmd.Flags().String(flagExpectedSupply, "", "expected total supply of the genesis once the accounts are added")
+
+	return cmd
+}
+
+// vestingParams are the vesting parameters of a genesis account.
+type vestingParams struct {
+	amount          sdk.Coins
+	start, end      int64
+	periods         authvesting.Periods
+	permanentLocked bool
+}
+
+// genesisAccount is an account to add to the genesis, with its balance.
+type genesisAccount struct {
+	account authtypes.GenesisAccount
+	balance banktypes.Balance
+}
+
+// newGenesisAccount returns the account holding the coins, of the concrete
+// type given by the vesting parameters.
+func newGenesisAccount(addr sdk.AccAddress, coins sdk.Coins, vesting vestingParams) (genesisAccount, error) {
+	var genAccount authtypes.GenesisAccount
+
+	balances := banktypes.Balance{Address: addr.String(), Coins: coins.Sort()}
+	baseAccount := authtypes.NewBaseAccount(addr, nil, 0, 0)
+
+	switch {
+	case vesting.permanentLocked:
+		if vesting.start != 0 || vesting.end != 0 || len(vesting.periods) > 0 {
+			return genesisAccount{}, errors.New("a permanently locked account has no vesting schedule")
+		}
+		locked := vesting.amount
+		if locked.IsZero() {
+			locked = balances.Coins
+		}
+		genAccount = authvesting.NewPermanentLockedAccount(baseAccount, locked.Sort())
+
+	case len(vesting.periods) > 0:
+		if vesting.end != 0 {
+			return genesisAccount{}, errors.New("the end time of a periodic vesting is given by its periods")
+		}
+		var originalVesting sdk.Coins
+		for _, period := range vesting.periods {
+			originalVesting = originalVesting.Add(period.Amount...)
+		}
+		if !vesting.amount.IsZero() && vesting.amount.String() != originalVesting.String() {
+			return genesisAccount{}, fmt.Errorf("vesting amount %s does not match the amount %s vesting in the periods", vesting.amount, originalVesting)
+		}
+		genAccount = authvesting.NewPeriodicVestingAccount(baseAccount, originalVesting, vesting.start, vesting.periods)
+
+	case !vesting.amount.IsZero():
+		baseVestingAccount := authvesting.NewBaseVestingAccount(baseAccount, vesting.amount.Sort(), vesting.end)
+
+		switch {
+		case vesting.start != 0 && vesting.end != 0:
+			genAccount = authvesting.NewContinuousVestingAccountRaw(baseVestingAccount, vesting.start)
+
+		case vesting.end != 0:
+			genAccount = authvesting.NewDelayedVestingAccountRaw(baseVestingAccount)
+
+		default:
+			return genesisAccount{}, errors.New("invalid vesting parameters; must supply start and end time or end time")
+		}
+
+	default:
+		genAccount = baseAccount
+	}
+
+	if vestingAccount, ok := genAccount.(vestingexported.VestingAccount); ok {
+		originalVesting := vestingAccount.GetOriginalVesting()
+		if (balances.Coins.IsZero() && !originalVesting.IsZero()) || originalVesting.IsAnyGT(balances.Coins) {
+			return genesisAccount{}, errors.New("vesting amount cannot be greater than total amount")
+		}
+	}
+
+	if err := genAccount.Validate(); err != nil {
+		return genesisAccount{}, fmt.Errorf("failed to validate new genesis account: %w", err)
+	}
+
+	return genesisAccount{account: genAccount, balance: balances}, nil
+}
+
+// addGenesisAccounts adds the accounts and their balances to the genesis. An
+// account may only already exist if appendCoins is set, its balance is then
+// credited with the coins and its type is kept, so the coins of a vesting
+// account cannot be appended.
+func addGenesisAccounts(cdc codec.Codec, appState map[string]json.RawMessage, genAccounts []genesisAccount, appendCoins bool) error {
+	authGenState := authtypes.GetGenesisStateFromAppState(cdc, appState)
+
+	accs, err := authtypes.UnpackAccounts(authGenState.Accounts)
+	if err != nil {
+		return fmt.Errorf("failed to get accounts from any: %w", err)
+	}
+
+	bankGenState := banktypes.GetGenesisStateFromAppState(cdc, appState)
+
+	for _, genAccount := range genAccounts {
+		addr := genAccount.account.GetAddress()
+		switch {
+		case !accs.Contains(addr):
+			// Add the new account to the set of genesis accounts
+			accs = append(accs, genAccount.account)
+			bankGenState.Balances = append(bankGenState.Balances, genAccount.balance)
+
+		case appendCoins:
+			if _, ok := genAccount.account.(vestingexported.VestingAccount); ok {
+				return fmt.Errorf("cannot append vesting coins to the existing account %s, the vesting parameters would be ignored", addr)
+			}
+			found := false
+			for i, balance := range bankGenState.Balances {
+				if balance.Address == genAccount.balance.Address {
+					bankGenState.Balances[i].Coins = balance.Coins.Add(genAccount.balance.Coins...)
+					found = true
+				}
+			}
+			if !found {
+				bankGenState.Balances = append(bankGenState.Balances, genAccount.balance)
 			}
 
-			appState[banktypes.ModuleName] = bankGenStateBz
+		default:
+			return fmt.Errorf("cannot add account at existing address %s, use --%s to append the coins to it", addr, flagAppendMode)
+		}
+		bankGenState.Supply = bankGenState.Supply.Add(genAccount.balance.Coins...)
+	}
 
-			appStateJSON, err := json.Marshal(appState)
-			if err != nil {
-				return fmt.Errorf("failed to marshal application genesis state: %w", err)
+	// sanitize the accounts and balances afterwards
+	accs = authtypes.SanitizeGenesisAccounts(accs)
+	bankGenState.Balances = banktypes.SanitizeGenesisBalances(bankGenState.Balances)
+
+	authGenState.Accounts, err = authtypes.PackAccounts(accs)
+	if err != nil {
+		return fmt.Errorf("failed to convert accounts into any's: %w", err)
+	}
+	if err := setModuleGenesis(cdc, appState, authtypes.ModuleName, &authGenState); err != nil {
+		return err
+	}
+
+	return setModuleGenesis(cdc, appState, banktypes.ModuleName, bankGenState)
+}
+
+// readVestingPeriods reads the start time and the periods of a periodic
+// vesting from a JSON file, in the format of create-periodic-vesting-account.
+func readVestingPeriods(path string) (int64, authvesting.Periods, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	var vestingData vestingcli.VestingData
+	if err := json.Unmarshal(bz, &vestingData); err != nil {
+		return 0, nil, fmt.Errorf("failed to parse vesting periods file: %w", err)
+	}
+
+	periods, err := parseVestingPeriods(vestingData.Periods)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	return vestingData.StartTime, periods, nil
+}
+
+func parseVestingPeriods(inputs []vestingcli.InputPeriod) (authvesting.Periods, error) {
+	if len(inputs) == 0 {
+		return nil, errors.New("no vesting periods")
+	}
+
+	periods := make(authvesting.Periods, 0, len(inputs))
+	for i, p := range inputs {
+		amount, err := sdk.ParseCoinsNormalized(p.Coins)
+		if err != nil {
+			return nil, fmt.Errorf("invalid coins of period %d: %w", i, err)
+		}
+		if p.Length <= 0 {
+			return nil, fmt.Errorf("invalid period length of %d in period %d, length must be greater than 0", p.Length, i)
+		}
+		periods = append(periods, authvesting.Period{Length: p.Length, Amount: amount})
+	}
+
+	return periods, nil
+}
+
+// bulkGenesisAccount is an account of the bulk-add-genesis-account file.
+type bulkGenesisAccount struct {
+	Address          string                   `json:"address"`
+	Coins            string                   `json:"coins"`
+	VestingAmount    string                   `json:"vesting_amount,omitempty"`
+	VestingStartTime int64                    `json:"vesting_start_time,omitempty"`
+	VestingEndTime   int64                    `json:"vesting_end_time,omitempty"`
+	VestingPeriods   []vestingcli.InputPeriod `json:"vesting_periods,omitempty"`
+	PermanentLocked  bool                     `json:"permanent_locked,omitempty"`
+}
+
+func (a bulkGenesisAccount) genesisAccount() (genesisAccount, error) {
+	addr, err := sdk.AccAddressFromBech32(a.Address)
+	if err != nil {
+		return genesisAccount{}, err
+	}
+	coins, err := sdk.ParseCoinsNormalized(a.Coins)
+	if err != nil {
+		return genesisAccount{}, fmt.Errorf("failed to parse coins: %w", err)
+	}
+
+	vesting := vestingParams{
+		start:           a.VestingStartTime,
+		end:             a.VestingEndTime,
+		permanentLocked: a.PermanentLocked,
+	}
+	vesting.amount, err = sdk.ParseCoinsNormalized(a.VestingAmount)
+	if err != nil {
+		return genesisAccount{}, fmt.Errorf("failed to parse vesting amount: %w", err)
+	}
+	if len(a.VestingPeriods) > 0 {
+		vesting.periods, err = parseVestingPeriods(a.VestingPeriods)
+		if err != nil {
+			return genesisAccount{}, err
+		}
+	}
+
+	return newGenesisAccount(addr, coins, vesting)
+}
+
+// readBulkGenesisAccounts reads the accounts of a JSON or CSV file, given by
+// its extension.
+func readBulkGenesisAccounts(path string) ([]bulkGenesisAccount, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	switch ext := strings.ToLower(filepath.Ext(path)); ext {
+	case ".json":
+		var accounts []bulkGenesisAccount
+		decoder := json.NewDecoder(f)
+		decoder.DisallowUnknownFields()
+		if err := decoder.Decode(&accounts); err != nil {
+			return nil, fmt.Errorf("failed to parse accounts file: %w", err)
+		}
+		return accounts, nil
+
+	case ".csv":
+		return readBulkGenesisAccountsCSV(f)
+
+	default:
+		return nil, fmt.Errorf("unsupported accounts file extension %q, expected .json or .csv", ext)
+	}
+}
+
+func readBulkGenesisAccountsCSV(r io.Reader) ([]bulkGenesisAccount, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read accounts file header: %w", err)
+	}
+	columns := make(map[string]int, len(header))
+	for i, column := range header {
+		column = strings.TrimSpace(column)
+		switch column {
+		case "address", "coins", "vesting_amount", "vesting_start_time", "vesting_end_time", "vesting_periods", "permanent_locked":
+		default:
+			return nil, fmt.Errorf("unknown column %q in accounts file", column)
+		}
+		columns[column] = i
+	}
+	for _, column := range []string{"address", "coins"} {
+		if _, ok := columns[column]; !ok {
+			return nil, fmt.Errorf("missing column %q in accounts file", column)
+		}
+	}
+
+	var accounts []bulkGenesisAccount
+	for line := 2; ; line++ {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return accounts, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read accounts file: %w", err)
+		}
+
+		field := func(column string) string {
+			if i, ok := columns[column]; ok {
+				return strings.TrimSpace(record[i])
+			}
+			return ""
+		}
+		account := bulkGenesisAccount{
+			Address:       field("address"),
+			Coins:         field("coins"),
+			VestingAmount: field("vesting_amount"),
+		}
+		if account.VestingStartTime, err = parseOptionalInt(field("vesting_start_time")); err != nil {
+			return nil, fmt.Errorf("invalid vesting start time on line %d: %w", line, err)
+		}
+		if account.VestingEndTime, err = parseOptionalInt(field("vesting_end_time")); err != nil {
+			return nil, fmt.Errorf("invalid vesting end time on line %d: %w", line, err)
+		}
+		if locked := field("permanent_locked"); locked != "" {
+			if account.PermanentLocked, err = strconv.ParseBool(locked); err != nil {
+				return nil, fmt.Errorf("invalid permanent locked flag on line %d: %w", line, err)
+			}
+		}
+		if periods := field("vesting_periods"); periods != "" {
+			for _, period := range strings.Split(periods, ";") {
+				length, coins, ok := strings.Cut(period, ":")
+				if !ok {
+					return nil, fmt.Errorf("invalid vesting period %q on line %d, expected length_seconds:coins", period, line)
+				}
+				lengthSeconds, err := strconv.ParseInt(strings.TrimSpace(length), 10, 64)
+				if err != nil {
+					return nil, fmt.Errorf("invalid vesting period length on line %d: %w", line, err)
+				}
+				account.VestingPeriods = append(account.VestingPeriods, vestingcli.InputPeriod{Coins: strings.TrimSpace(coins), Length: lengthSeconds})
 			}
+		}
 
-			genDoc.AppState = appStateJSON
-			return genutil.ExportGenesisFile(genDoc, genFile)
-		},
+		accounts = append(accounts, account)
 	}
+}
 
-	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
-	cmd.Flags().String(flags.FlagKeyringBackend, flags.DefaultKeyringBackend, "Select keyring's backend (os|file|kwallet|pass|test)")
-	cmd.Flags().String(flagVestingAmt, "", "amount of coins for vesting accounts")
-	cmd.Flags().Int64(flagVestingStart, 0, "schedule start time (unix epoch) for vesting accounts")
-	cmd.Flags().Int64(flagVestingEnd, 0, "schedule end time (unix epoch) for vesting accounts")
-	flags.AddQueryFlagsToCmd(cmd)
+func parseOptionalInt(s string) (int64, error) {
+	if s == "" {
+		return 0, nil
+	}
 
-	return cmd
+	return strconv.ParseInt(s, 10, 64)
 }
@@ -0,0 +1,173 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingcli "github.com/cosmos/cosmos-sdk/x/auth/vesting/client/cli"
+	authvesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	gaia "github.com/cosmos/gaia/v17/app"
+)
+
+func TestNewGenesisAccount(t *testing.T) {
+	addr := sdk.AccAddress("genesis-account")
+	coins := sdk.NewCoins(sdk.NewInt64Coin("uatom", 100))
+	periods := authvesting.Periods{
+		{Length: 10, Amount: sdk.NewCoins(sdk.NewInt64Coin("uatom", 30))},
+		{Length: 10, Amount: sdk.NewCoins(sdk.NewInt64Coin("uatom", 30))},
+	}
+
+	tests := map[string]struct {
+		vesting   vestingParams
+		expected  authtypes.GenesisAccount
+		expectErr string
+	}{
+		"base account": {
+			expected: &authtypes.BaseAccount{},
+		},
+		"continuous vesting": {
+			vesting:  vestingParams{amount: sdk.NewCoins(sdk.NewInt64Coin("uatom", 50)), start: 10, end: 20},
+			expected: &authvesting.ContinuousVestingAccount{},
+		},
+		"delayed vesting": {
+			vesting:  vestingParams{amount: sdk.NewCoins(sdk.NewInt64Coin("uatom", 50)), end: 20},
+			expected: &authvesting.DelayedVestingAccount{},
+		},
+		"periodic vesting": {
+			vesting:  vestingParams{start: 10, periods: periods},
+			expected: &authvesting.PeriodicVestingAccount{},
+		},
+		"permanent locked": {
+			vesting:  vestingParams{permanentLocked: true},
+			expected: &authvesting.PermanentLockedAccount{},
+		},
+		"vesting amount without end time": {
+			vesting:   vestingParams{amount: sdk.NewCoins(sdk.NewInt64Coin("uatom", 50))},
+			expectErr: "must supply start and end time or end time",
+		},
+		"vesting more than the balance": {
+			vesting:   vestingParams{amount: sdk.NewCoins(sdk.NewInt64Coin("uatom", 101)), end: 20},
+			expectErr: "vesting amount cannot be greater than total amount",
+		},
+		"periods not matching the vesting amount": {
+			vesting:   vestingParams{amount: sdk.NewCoins(sdk.NewInt64Coin("uatom", 50)), start: 10, periods: periods},
+			expectErr: "does not match the amount 60uatom vesting in the periods",
+		},
+		"permanent locked with a schedule": {
+			vesting:   vestingParams{permanentLocked: true, end: 20},
+			expectErr: "a permanently locked account has no vesting schedule",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			genAccount, err := newGenesisAccount(addr, coins, tc.vesting)
+			if tc.expectErr != "" {
+				require.ErrorContains(t, err, tc.expectErr)
+				return
+			}
+			require.NoError(t, err)
+			require.IsType(t, tc.expected, genAccount.account)
+			require.Equal(t, addr, genAccount.account.GetAddress())
+			require.Equal(t, coins, genAccount.balance.Coins)
+		})
+	}
+
+	genAccount, err := newGenesisAccount(addr, coins, vestingParams{permanentLocked: true})
+	require.NoError(t, err)
+	require.Equal(t, coins, genAccount.account.(*authvesting.PermanentLockedAccount).OriginalVesting)
+}
+
+func TestParseVestingPeriods(t *testing.T) {
+	periods, err := parseVestingPeriods([]vestingcli.InputPeriod{{Coins: "30uatom", Length: 10}})
+	require.NoError(t, err)
+	require.Equal(t, authvesting.Periods{{Length: 10, Amount: sdk.NewCoins(sdk.NewInt64Coin("uatom", 30))}}, periods)
+
+	_, err = parseVestingPeriods(nil)
+	require.ErrorContains(t, err, "no vesting periods")
+	_, err = parseVestingPeriods([]vestingcli.InputPeriod{{Coins: "30uatom", Length: -1}})
+	require.ErrorContains(t, err, "invalid period length")
+	_, err = parseVestingPeriods([]vestingcli.InputPeriod{{Coins: "30uatom", Length: 0}})
+	require.ErrorContains(t, err, "invalid period length")
+
+	// a periods file without periods is rejected
+	file := filepath.Join(t.TempDir(), "periods.json")
+	require.NoError(t, os.WriteFile(file, []byte(`{"start_time": 10, "periods": []}`), 0o600))
+	_, _, err = readVestingPeriods(file)
+	require.ErrorContains(t, err, "no vesting periods")
+}
+
+func TestReadBulkGenesisAccounts(t *testing.T) {
+	dir := t.TempDir()
+	addr1 := sdk.AccAddress("genesis-account-1").String()
+	addr2 := sdk.AccAddress("genesis-account-2").String()
+
+	csvFile := filepath.Join(dir, "accounts.csv")
+	require.NoError(t, os.WriteFile(csvFile, []byte(`address,coins,vesting_start_time,vesting_periods,permanent_locked
+`+addr1+`,"100uatom,5stake",10,10:30uatom;10:20uatom,
+`+addr2+`,50uatom,,,true
+`), 0o600))
+	jsonFile := filepath.Join(dir, "accounts.json")
+	require.NoError(t, os.WriteFile(jsonFile, []byte(`[
+		{"address": "`+addr1+`", "coins": "100uatom,5stake", "vesting_start_time": 10,
+		 "vesting_periods": [{"coins": "30uatom", "length_seconds": 10}, {"coins": "20uatom", "length_seconds": 10}]},
+		{"address": "`+addr2+`", "coins": "50uatom", "permanent_locked": true}
+	]`), 0o600))
+
+	expected := []bulkGenesisAccount{
+		{
+			Address:          addr1,
+			Coins:            "100uatom,5stake",
+			VestingStartTime: 10,
+			VestingPeriods:   []vestingcli.InputPeriod{{Coins: "30uatom", Length: 10}, {Coins: "20uatom", Length: 10}},
+		},
+		{Address: addr2, Coins: "50uatom", PermanentLocked: true},
+	}
+	for _, file := range []string{csvFile, jsonFile} {
+		entries, err := readBulkGenesisAccounts(file)
+		require.NoError(t, err)
+		require.Equal(t, expected, entries, file)
+	}
+
+	badFile := filepath.Join(dir, "accounts.csv")
+	require.NoError(t, os.WriteFile(badFile, []byte("address,amount\n"), 0o600))
+	_, err := readBulkGenesisAccounts(badFile)
+	require.ErrorContains(t, err, `unknown column "amount"`)
+	txtFile := filepath.Join(dir, "accounts.txt")
+	require.NoError(t, os.WriteFile(txtFile, nil, 0o600))
+	_, err = readBulkGenesisAccounts(txtFile)
+	require.ErrorContains(t, err, `unsupported accounts file extension ".txt"`)
+
+	// the accounts are added to the genesis
+	cdc := gaia.RegisterEncodingConfig().Marshaler
+	appState := gaia.NewDefaultGenesisState(gaia.RegisterEncodingConfig())
+	accounts := make([]genesisAccount, 0, len(expected))
+	for _, entry := range expected {
+		account, err := entry.genesisAccount()
+		require.NoError(t, err)
+		accounts = append(accounts, account)
+	}
+	require.NoError(t, addGenesisAccounts(cdc, appState, accounts, false))
+	require.ErrorContains(t, addGenesisAccounts(cdc, appState, accounts[:1], false), "cannot add account at existing address")
+	// the vesting parameters cannot be appended to an existing account
+	require.ErrorContains(t, addGenesisAccounts(cdc, appState, accounts[1:], true), "cannot append vesting coins to the existing account")
+	account, err := bulkGenesisAccount{Address: addr2, Coins: "50uatom"}.genesisAccount()
+	require.NoError(t, err)
+	require.NoError(t, addGenesisAccounts(cdc, appState, []genesisAccount{account}, true))
+
+	authGenState := authtypes.GetGenesisStateFromAppState(cdc, appState)
+	accs, err := authtypes.UnpackAccounts(authGenState.Accounts)
+	require.NoError(t, err)
+	require.Len(t, accs, 2)
+	bankGenState := banktypes.GetGenesisStateFromAppState(cdc, appState)
+	require.Len(t, bankGenState.Balances, 2)
+	require.Equal(t, "5stake,200uatom", bankGenState.Supply.String())
+	require.NoError(t, bankGenState.Validate())
+}
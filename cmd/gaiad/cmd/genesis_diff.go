@@ -0,0 +1,644 @@
+package cmd
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	ibctransfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/cosmos/gaia/v17/app/params"
+)
+
+const (
+	flagDiffModules = "modules"
+	flagSummary     = "summary"
+	flagMaxChanges  = "max-changes"
+)
+
+// GenesisDiffCommand returns the genesis-diff cobra Command.
+func GenesisDiffCommand(encodingConfig params.EncodingConfig) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "genesis-diff [genesis-a] [genesis-b]",
+		Short: "Compare the app state of two genesis files module by module",
+		Long: `Compare the app state of two genesis files, e.g. exported before and after an
+upgrade, module by module. The order of lists is ignored.
+
+The balances, delegations and IBC denoms are decoded with the module codecs
+and compared per account, delegation and denom. The params of each module are
+compared as a whole. Any other difference is reported with its JSON path.
+
+Files ending in .gz or .zst are decompressed.
+
+Example:
+	gaiad debug genesis-diff before.json after.json
+	gaiad debug genesis-diff before.json after.json --modules bank,staking --output json
+	`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			output, err := cmd.Flags().GetString(flags.FlagOutput)
+			if err != nil {
+				return err
+			}
+			modules, err := cmd.Flags().GetStringSlice(flagDiffModules)
+			if err != nil {
+				return err
+			}
+			summary, err := cmd.Flags().GetBool(flagSummary)
+			if err != nil {
+				return err
+			}
+			maxChanges, err := cmd.Flags().GetInt(flagMaxChanges)
+			if err != nil {
+				return err
+			}
+
+			before, err := readAppState(args[0])
+			if err != nil {
+				return err
+			}
+			after, err := readAppState(args[1])
+			if err != nil {
+				return err
+			}
+
+			diff, err := diffGenesis(encodingConfig.Marshaler, before, after, modules)
+			if err != nil {
+				return err
+			}
+
+			switch output {
+			case "json":
+				if summary {
+					for i := range diff {
+						diff[i] = diff[i].summary()
+					}
+				}
+				out, err := json.MarshalIndent(diff, "", "  ")
+				if err != nil {
+					return err
+				}
+				cmd.Println(string(out))
+			case "text":
+				printGenesisDiff(cmd.OutOrStdout(), diff, summary, maxChanges)
+			default:
+				return fmt.Errorf("unknown output %q, expected text or json", output)
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flags.FlagOutput, "text", "Output format (text|json)")
+	cmd.Flags().StringSlice(flagDiffModules, nil, "Comma-separated list of modules to compare, all modules if empty")
+	cmd.Flags().Bool(flagSummary, false, "Print the number of changes of each module only")
+	cmd.Flags().Int(flagMaxChanges, 20, "Maximum number of changes printed per module in text output, 0 prints all")
+
+	return cmd
+}
+
+// ModuleDiff lists the differences of the state of a module between two
+// genesis files.
+type ModuleDiff struct {
+	Module string `json:"module"`
+	// Status is added, removed or changed
+	Status      string          `json:"status"`
+	Changes     int             `json:"changes"`
+	Params      *ParamsDiff     `json:"params,omitempty"`
+	Balances    []BalanceDiff   `json:"balances,omitempty"`
+	Delegations []DelegateDiff  `json:"delegations,omitempty"`
+	Denoms      *DenomsDiff     `json:"denoms,omitempty"`
+	Values      []JSONValueDiff `json:"values,omitempty"`
+}
+
+// ParamsDiff is a change of the params of a module.
+type ParamsDiff struct {
+	Before json.RawMessage `json:"before"`
+	After  json.RawMessage `json:"after"`
+}
+
+// BalanceDiff is a change of the balance of an account.
+type BalanceDiff struct {
+	Address string    `json:"address"`
+	Before  sdk.Coins `json:"before"`
+	After   sdk.Coins `json:"after"`
+}
+
+// DelegateDiff is a change of the shares of a delegation.
+type DelegateDiff struct {
+	Delegator string  `json:"delegator"`
+	Validator string  `json:"validator"`
+	Before    sdk.Dec `json:"before"`
+	After     sdk.Dec `json:"after"`
+}
+
+// DenomsDiff lists the IBC denoms, as full paths, added or removed.
+type DenomsDiff struct {
+	Added   []string `json:"added,omitempty"`
+	Removed []string `json:"removed,omitempty"`
+}
+
+// JSONValueDiff is any other difference, at the given JSON path. A value
+// only present in a list is reported with a null Before or After.
+type JSONValueDiff struct {
+	Path   string          `json:"path"`
+	Before json.RawMessage `json:"before"`
+	After  json.RawMessage `json:"after"`
+}
+
+func (d ModuleDiff) summary() ModuleDiff {
+	return ModuleDiff{Module: d.Module, Status: d.Status, Changes: d.Changes}
+}
+
+// readAppState reads the app state of a genesis file, decompressed according
+// to the file extension.
+func readAppState(path string) (map[string]json.RawMessage, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	compression := compressionNone
+	switch filepath.Ext(path) {
+	case ".gz":
+		compression = compressionGzip
+	case ".zst":
+		compression = compressionZstd
+	}
+	r, err := decompressReader(bufio.NewReader(f), compression)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+
+	var genesis struct {
+		AppState map[string]json.RawMessage `json:"app_state"`
+	}
+	if err := json.NewDecoder(r).Decode(&genesis); err != nil {
+		return nil, fmt.Errorf("failed to decode %s: %w", path, err)
+	}
+
+	return genesis.AppState, nil
+}
+
+// diffGenesis compares the app states module by module and returns the
+// modules that differ, sorted by name.
+func diffGenesis(cdc codec.JSONCodec, before, after map[string]json.RawMessage, modules []string) ([]ModuleDiff, error) {
+	if len(modules) == 0 {
+		for name := range before {
+			modules = append(modules, name)
+		}
+		for name := range after {
+			if _, ok := before[name]; !ok {
+				modules = append(modules, name)
+			}
+		}
+	}
+	sort.Strings(modules)
+
+	var diffs []ModuleDiff
+	for _, name := range modules {
+		from, inBefore := before[name]
+		to, inAfter := after[name]
+		if !inBefore && !inAfter {
+			return nil, fmt.Errorf("module %s is in neither genesis", name)
+		}
+
+		diff, err := diffModule(cdc, name, from, to)
+		if err != nil {
+			return nil, fmt.Errorf("module %s: %w", name, err)
+		}
+		switch {
+		case !inBefore:
+			diff.Status = "added"
+		case !inAfter:
+			diff.Status = "removed"
+		case diff.Changes == 0:
+			continue
+		default:
+			diff.Status = "changed"
+		}
+		diffs = append(diffs, diff)
+	}
+
+	return diffs, nil
+}
+
+// diffModule compares the state of a module. The fields decoded by a module
+// specific comparison are left out of the generic JSON comparison.
+func diffModule(cdc codec.JSONCodec, name string, before, after json.RawMessage) (ModuleDiff, error) {
+	diff := ModuleDiff{Module: name}
+	decoded := map[string]bool{"params": true}
+
+	var err error
+	switch name {
+	case banktypes.ModuleName:
+		diff.Balances, err = diffBalances(cdc, before, after)
+		decoded["balances"] = true
+	case stakingtypes.ModuleName:
+		diff.Delegations, err = diffDelegations(cdc, before, after)
+		decoded["delegations"] = true
+	case ibctransfertypes.ModuleName:
+		diff.Denoms, err = diffDenoms(cdc, before, after)
+		decoded["denom_traces"] = true
+	}
+	if err != nil {
+		return diff, err
+	}
+
+	beforeFields, err := decodeFields(before)
+	if err != nil {
+		return diff, err
+	}
+	afterFields, err := decodeFields(after)
+	if err != nil {
+		return diff, err
+	}
+
+	if !jsonEqual(beforeFields["params"], afterFields["params"]) {
+		diff.Params = &ParamsDiff{Before: beforeFields["params"], After: afterFields["params"]}
+	}
+
+	for _, field := range unionKeys(beforeFields, afterFields) {
+		if decoded[field] {
+			continue
+		}
+		values, err := diffJSON(field, beforeFields[field], afterFields[field])
+		if err != nil {
+			return diff, err
+		}
+		diff.Values = append(diff.Values, values...)
+	}
+
+	diff.Changes = len(diff.Balances) + len(diff.Delegations) + len(diff.Values)
+	if diff.Params != nil {
+		diff.Changes++
+	}
+	if diff.Denoms != nil {
+		diff.Changes += len(diff.Denoms.Added) + len(diff.Denoms.Removed)
+	}
+
+	return diff, nil
+}
+
+func diffBalances(cdc codec.JSONCodec, before, after json.RawMessage) ([]BalanceDiff, error) {
+	balances := func(bz json.RawMessage) (map[string]sdk.Coins, error) {
+		var genesis banktypes.GenesisState
+		if err := unmarshalGenesis(cdc, bz, &genesis); err != nil {
+			return nil, err
+		}
+		balances := make(map[string]sdk.Coins, len(genesis.Balances))
+		for _, balance := range genesis.Balances {
+			balances[balance.Address] = balances[balance.Address].Add(balance.Coins...)
+		}
+		return balances, nil
+	}
+
+	from, err := balances(before)
+	if err != nil {
+		return nil, err
+	}
+	to, err := balances(after)
+	if err != nil {
+		return nil, err
+	}
+
+	var diffs []BalanceDiff
+	for _, address := range unionKeys(from, to) {
+		// coins of different denoms make Coins.IsEqual panic
+		if from[address].String() != to[address].String() {
+			diffs = append(diffs, BalanceDiff{Address: address, Before: from[address], After: to[address]})
+		}
+	}
+
+	return diffs, nil
+}
+
+func diffDelegations(cdc codec.JSONCodec, before, after json.RawMessage) ([]DelegateDiff, error) {
+	delegations := func(bz json.RawMessage) (map[string]stakingtypes.Delegation, error) {
+		var genesis stakingtypes.GenesisState
+		if err := unmarshalGenesis(cdc, bz, &genesis); err != nil {
+			return nil, err
+		}
+		delegations := make(map[string]stakingtypes.Delegation, len(genesis.Delegations))
+		for _, delegation := range genesis.Delegations {
+			delegations[delegation.DelegatorAddress+"/"+delegation.ValidatorAddress] = delegation
+		}
+		return delegations, nil
+	}
+
+	from, err := delegations(before)
+	if err != nil {
+		return nil, err
+	}
+	to, err := delegations(after)
+	if err != nil {
+		return nil, err
+	}
+
+	var diffs []DelegateDiff
+	for _, key := range unionKeys(from, to) {
+		fromShares, toShares := sdk.ZeroDec(), sdk.ZeroDec()
+		delegation, ok := from[key]
+		if ok {
+			fromShares = delegation.Shares
+		}
+		if d, ok := to[key]; ok {
+			delegation, toShares = d, d.Shares
+		}
+
+		if !fromShares.Equal(toShares) {
+			diffs = append(diffs, DelegateDiff{
+				Delegator: delegation.DelegatorAddress,
+				Validator: delegation.ValidatorAddress,
+				Before:    fromShares,
+				After:     toShares,
+			})
+		}
+	}
+
+	return diffs, nil
+}
+
+func diffDenoms(cdc codec.JSONCodec, before, after json.RawMessage) (*DenomsDiff, error) {
+	denoms := func(bz json.RawMessage) (map[string]bool, error) {
+		var genesis ibctransfertypes.GenesisState
+		if err := unmarshalGenesis(cdc, bz, &genesis); err != nil {
+			return nil, err
+		}
+		denoms := make(map[string]bool, len(genesis.DenomTraces))
+		for _, trace := range genesis.DenomTraces {
+			denoms[trace.GetFullDenomPath()] = true
+		}
+		return denoms, nil
+	}
+
+	from, err := denoms(before)
+	if err != nil {
+		return nil, err
+	}
+	to, err := denoms(after)
+	if err != nil {
+		return nil, err
+	}
+
+	var diff DenomsDiff
+	for _, denom := range unionKeys(from, to) {
+		switch {
+		case !from[denom]:
+			diff.Added = append(diff.Added, denom)
+		case !to[denom]:
+			diff.Removed = append(diff.Removed, denom)
+		}
+	}
+	if len(diff.Added) == 0 && len(diff.Removed) == 0 {
+		return nil, nil
+	}
+
+	return &diff, nil
+}
+
+// unmarshalGenesis decodes the genesis of a module, a missing genesis being
+// decoded as an empty one.
+func unmarshalGenesis(cdc codec.JSONCodec, bz json.RawMessage, genesis codec.ProtoMarshaler) error {
+	if len(bz) == 0 || bytes.Equal(bz, []byte("null")) {
+		return nil
+	}
+
+	return cdc.UnmarshalJSON(bz, genesis)
+}
+
+// decodeFields returns the top-level fields of a module genesis.
+func decodeFields(bz json.RawMessage) (map[string]json.RawMessage, error) {
+	fields := map[string]json.RawMessage{}
+	if len(bz) == 0 || bytes.Equal(bz, []byte("null")) {
+		return fields, nil
+	}
+	if err := json.Unmarshal(bz, &fields); err != nil {
+		return nil, err
+	}
+
+	return fields, nil
+}
+
+// diffJSON compares two JSON values and returns their differences. Objects
+// are compared field by field and lists as multisets, i.e. ignoring order.
+func diffJSON(path string, before, after json.RawMessage) ([]JSONValueDiff, error) {
+	if jsonEqual(before, after) {
+		return nil, nil
+	}
+
+	var from, to interface{}
+	if err := unmarshalJSON(before, &from); err != nil {
+		return nil, err
+	}
+	if err := unmarshalJSON(after, &to); err != nil {
+		return nil, err
+	}
+
+	fromObject, fromIsObject := from.(map[string]interface{})
+	toObject, toIsObject := to.(map[string]interface{})
+	if fromIsObject && toIsObject {
+		var diffs []JSONValueDiff
+		for _, key := range unionKeys(fromObject, toObject) {
+			d, err := diffJSON(path+"."+key, mustCanonicalJSON(fromObject[key], fromObject, key), mustCanonicalJSON(toObject[key], toObject, key))
+			if err != nil {
+				return nil, err
+			}
+			diffs = append(diffs, d...)
+		}
+		return diffs, nil
+	}
+
+	fromList, fromIsList := from.([]interface{})
+	toList, toIsList := to.([]interface{})
+	if fromIsList && toIsList {
+		// count each element by its canonical encoding
+		counts := make(map[string]int)
+		for _, v := range fromList {
+			counts[string(mustCanonicalJSON(v, nil, ""))]++
+		}
+		for _, v := range toList {
+			counts[string(mustCanonicalJSON(v, nil, ""))]--
+		}
+
+		var diffs []JSONValueDiff
+		for _, element := range sortedKeys(counts) {
+			for n := counts[element]; n > 0; n-- {
+				diffs = append(diffs, JSONValueDiff{Path: path + "[]", Before: json.RawMessage(element)})
+			}
+			for n := counts[element]; n < 0; n++ {
+				diffs = append(diffs, JSONValueDiff{Path: path + "[]", After: json.RawMessage(element)})
+			}
+		}
+		return diffs, nil
+	}
+
+	return []JSONValueDiff{{Path: path, Before: before, After: after}}, nil
+}
+
+// jsonEqual returns true if both JSON values are equal, ignoring formatting,
+// the order of object fields and the order of lists.
+func jsonEqual(a, b json.RawMessage) bool {
+	if bytes.Equal(a, b) {
+		return true
+	}
+
+	var x, y interface{}
+	if unmarshalJSON(a, &x) != nil || unmarshalJSON(b, &y) != nil {
+		return false
+	}
+
+	return bytes.Equal(mustCanonicalJSON(sortLists(x), nil, ""), mustCanonicalJSON(sortLists(y), nil, ""))
+}
+
+func unmarshalJSON(bz json.RawMessage, v *interface{}) error {
+	if len(bz) == 0 {
+		return nil
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(bz))
+	decoder.UseNumber()
+	return decoder.Decode(v)
+}
+
+// mustCanonicalJSON encodes the value with sorted object fields. A field
+// missing from its object is encoded as nil.
+func mustCanonicalJSON(v interface{}, object map[string]interface{}, key string) json.RawMessage {
+	if object != nil {
+		if _, ok := object[key]; !ok {
+			return nil
+		}
+	}
+
+	bz, err := json.Marshal(v)
+	if err != nil {
+		panic(err)
+	}
+
+	return bz
+}
+
+// sortLists returns the value with all its lists sorted by the canonical
+// encoding of their elements.
+func sortLists(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for key, value := range v {
+			v[key] = sortLists(value)
+		}
+		return v
+	case []interface{}:
+		for i, value := range v {
+			v[i] = sortLists(value)
+		}
+		sort.SliceStable(v, func(i, j int) bool {
+			return string(mustCanonicalJSON(v[i], nil, "")) < string(mustCanonicalJSON(v[j], nil, ""))
+		})
+		return v
+	default:
+		return v
+	}
+}
+
+func unionKeys[V any](a, b map[string]V) []string {
+	keys := sortedKeys(a)
+	for key := range b {
+		if _, ok := a[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	return keys
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
+
+func printGenesisDiff(w io.Writer, diffs []ModuleDiff, summary bool, maxChanges int) {
+	if len(diffs) == 0 {
+		fmt.Fprintln(w, "no differences")
+		return
+	}
+
+	for _, diff := range diffs {
+		fmt.Fprintf(w, "%s: %s, %d changes\n", diff.Module, diff.Status, diff.Changes)
+		if summary {
+			continue
+		}
+
+		var lines []string
+		if diff.Params != nil {
+			lines = append(lines, fmt.Sprintf("params: %s -> %s", compactJSON(diff.Params.Before), compactJSON(diff.Params.After)))
+		}
+		for _, balance := range diff.Balances {
+			lines = append(lines, fmt.Sprintf("balance %s: %s -> %s", balance.Address, coinsString(balance.Before), coinsString(balance.After)))
+		}
+		for _, delegation := range diff.Delegations {
+			lines = append(lines, fmt.Sprintf("delegation %s to %s: %s -> %s shares", delegation.Delegator, delegation.Validator, delegation.Before, delegation.After))
+		}
+		if diff.Denoms != nil {
+			for _, denom := range diff.Denoms.Added {
+				lines = append(lines, fmt.Sprintf("denom added: %s", denom))
+			}
+			for _, denom := range diff.Denoms.Removed {
+				lines = append(lines, fmt.Sprintf("denom removed: %s", denom))
+			}
+		}
+		for _, value := range diff.Values {
+			lines = append(lines, fmt.Sprintf("%s: %s -> %s", value.Path, compactJSON(value.Before), compactJSON(value.After)))
+		}
+
+		for i, line := range lines {
+			if maxChanges > 0 && i == maxChanges {
+				fmt.Fprintf(w, "  ... %d more\n", len(lines)-maxChanges)
+				break
+			}
+			fmt.Fprintf(w, "  %s\n", line)
+		}
+	}
+}
+
+func coinsString(coins sdk.Coins) string {
+	if coins.Empty() {
+		return "0"
+	}
+
+	return coins.String()
+}
+
+func compactJSON(bz json.RawMessage) string {
+	if len(bz) == 0 {
+		return "<none>"
+	}
+
+	var buf bytes.Buffer
+	if err := json.Compact(&buf, bz); err != nil {
+		return strings.TrimSpace(string(bz))
+	}
+
+	return buf.String()
+}
@@ -0,0 +1,126 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	gaia "github.com/cosmos/gaia/v17/app"
+)
+
+func TestDiffGenesis(t *testing.T) {
+	cdc := gaia.RegisterEncodingConfig().Marshaler
+
+	before := map[string]json.RawMessage{
+		"bank": json.RawMessage(`{
+			"params": {"default_send_enabled": true},
+			"balances": [
+				{"address": "cosmos1a", "coins": [{"denom": "uatom", "amount": "10"}]},
+				{"address": "cosmos1b", "coins": [{"denom": "uatom", "amount": "5"}]}
+			],
+			"supply": [{"denom": "uatom", "amount": "15"}]
+		}`),
+		"staking": json.RawMessage(`{
+			"delegations": [
+				{"delegator_address": "cosmos1a", "validator_address": "cosmosvaloper1v", "shares": "10.000000000000000000"}
+			]
+		}`),
+		"transfer": json.RawMessage(`{
+			"port_id": "transfer",
+			"denom_traces": [{"path": "transfer/channel-0", "base_denom": "uosmo"}]
+		}`),
+		"gov":     json.RawMessage(`{"params": {"quorum": "0.4"}}`),
+		"removed": json.RawMessage(`{}`),
+	}
+	after := map[string]json.RawMessage{
+		// the order of lists is ignored
+		"bank": json.RawMessage(`{
+			"supply": [{"denom": "uatom", "amount": "15"}],
+			"balances": [
+				{"address": "cosmos1b", "coins": [{"denom": "uatom", "amount": "5"}]},
+				{"address": "cosmos1a", "coins": [{"denom": "uatom", "amount": "10"}]}
+			],
+			"params": {"default_send_enabled": true}
+		}`),
+		"staking": json.RawMessage(`{
+			"delegations": [
+				{"delegator_address": "cosmos1a", "validator_address": "cosmosvaloper1v", "shares": "12.000000000000000000"}
+			]
+		}`),
+		"transfer": json.RawMessage(`{
+			"port_id": "transfer",
+			"denom_traces": [{"path": "transfer/channel-1", "base_denom": "uosmo"}]
+		}`),
+		"gov":   json.RawMessage(`{"params": {"quorum": "0.5"}}`),
+		"added": json.RawMessage(`{"items": [1, 2]}`),
+	}
+
+	diffs, err := diffGenesis(cdc, before, after, nil)
+	require.NoError(t, err)
+
+	require.Equal(t, []ModuleDiff{
+		{
+			Module:  "added",
+			Status:  "added",
+			Changes: 1,
+			Values:  []JSONValueDiff{{Path: "items", After: json.RawMessage(`[1, 2]`)}},
+		},
+		{
+			Module:  "gov",
+			Status:  "changed",
+			Changes: 1,
+			Params:  &ParamsDiff{Before: json.RawMessage(`{"quorum": "0.4"}`), After: json.RawMessage(`{"quorum": "0.5"}`)},
+		},
+		{
+			Module: "removed",
+			Status: "removed",
+		},
+		{
+			Module:  "staking",
+			Status:  "changed",
+			Changes: 1,
+			Delegations: []DelegateDiff{
+				{Delegator: "cosmos1a", Validator: "cosmosvaloper1v", Before: sdk.NewDec(10), After: sdk.NewDec(12)},
+			},
+		},
+		{
+			Module:  "transfer",
+			Status:  "changed",
+			Changes: 2,
+			Denoms: &DenomsDiff{
+				Added:   []string{"transfer/channel-1/uosmo"},
+				Removed: []string{"transfer/channel-0/uosmo"},
+			},
+		},
+	}, diffs)
+
+	// a balance change
+	after["bank"] = json.RawMessage(`{"balances": [{"address": "cosmos1a", "coins": [{"denom": "uatom", "amount": "11"}]}]}`)
+	diffs, err = diffGenesis(cdc, before, after, []string{"bank"})
+	require.NoError(t, err)
+	require.Len(t, diffs, 1)
+	require.Equal(t, []BalanceDiff{
+		{Address: "cosmos1a", Before: sdk.NewCoins(sdk.NewInt64Coin("uatom", 10)), After: sdk.NewCoins(sdk.NewInt64Coin("uatom", 11))},
+		{Address: "cosmos1b", Before: sdk.NewCoins(sdk.NewInt64Coin("uatom", 5))},
+	}, diffs[0].Balances)
+	require.Equal(t, []JSONValueDiff{
+		{Path: "supply", Before: json.RawMessage(`[{"denom": "uatom", "amount": "15"}]`)},
+	}, diffs[0].Values)
+	require.NotNil(t, diffs[0].Params)
+	require.Equal(t, 4, diffs[0].Changes)
+
+	var out bytes.Buffer
+	printGenesisDiff(&out, diffs, false, 2)
+	require.Equal(t, `bank: changed, 4 changes
+  params: {"default_send_enabled":true} -> <none>
+  balance cosmos1a: 10uatom -> 11uatom
+  ... 2 more
+`, out.String())
+
+	_, err = diffGenesis(cdc, before, after, []string{"unknown"})
+	require.ErrorContains(t, err, "module unknown is in neither genesis")
+}
@@ -0,0 +1,545 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cometbft/cometbft/crypto"
+	tmjson "github.com/cometbft/cometbft/libs/json"
+	"github.com/cometbft/cometbft/privval"
+	tmtypes "github.com/cometbft/cometbft/types"
+
+	providertypes "github.com/cosmos/interchain-security/v4/x/ccv/provider/types"
+
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	evidencetypes "github.com/cosmos/cosmos-sdk/x/evidence/types"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	globalfeetypes "github.com/cosmos/gaia/v17/x/globalfee/types"
+)
+
+const (
+	flagMinGasPrices                    = "minimum-gas-prices"
+	flagBypassMinFeeMsgTypes            = "bypass-min-fee-msg-types"
+	flagMaxTotalBypassMinFeeMsgGasUsage = "max-total-bypass-min-fee-msg-gas-usage"
+	flagValidator                       = "validator"
+)
+
+// GenesisModifyCmd returns the genesis modify cobra Command, which edits the
+// genesis file offline, e.g. to start a testnet from a mainnet export.
+func GenesisModifyCmd(defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "modify",
+		Short: "Edit the genesis file offline, e.g. to start a testnet from an exported state",
+		Long: `Edit the genesis file of the node home, e.g. an exported mainnet state, to
+start a testnet from it with a local validator.
+
+Example:
+	gaiad genesis modify replace-validator
+	gaiad genesis modify drop-consumers
+	gaiad genesis modify set-voting-period 5m
+	gaiad genesis modify set-unbonding-period 10m
+	gaiad genesis modify bump-balance cosmos1... 1000000000uatom
+	`,
+		DisableFlagParsing:         false,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		setVotingPeriodCmd(),
+		setUnbondingPeriodCmd(),
+		setGlobalFeeCmd(),
+		dropConsumersCmd(),
+		bumpBalanceCmd(),
+		replaceValidatorCmd(),
+	)
+	cmd.PersistentFlags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+
+	return cmd
+}
+
+func setVotingPeriodCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "set-voting-period [duration]",
+		Short: "Set the voting period of governance proposals, e.g. 5m",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			period, err := parsePeriod(args[0])
+			if err != nil {
+				return err
+			}
+
+			return modifyGenesis(cmd, func(cdc codec.Codec, appState map[string]json.RawMessage, _ *tmtypes.GenesisDoc) error {
+				var govGenState govv1.GenesisState
+				if err := cdc.UnmarshalJSON(appState[govtypes.ModuleName], &govGenState); err != nil {
+					return err
+				}
+
+				if govGenState.Params == nil {
+					params := govv1.DefaultParams()
+					govGenState.Params = &params
+				}
+				govGenState.Params.VotingPeriod = &period
+				// deprecated params, still honored by the gov module if set
+				if govGenState.VotingParams != nil {
+					govGenState.VotingParams.VotingPeriod = &period
+				}
+
+				return setModuleGenesis(cdc, appState, govtypes.ModuleName, &govGenState)
+			})
+		},
+	}
+}
+
+func setUnbondingPeriodCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "set-unbonding-period [duration]",
+		Short: "Set the staking unbonding period, e.g. 10m",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			period, err := parsePeriod(args[0])
+			if err != nil {
+				return err
+			}
+
+			return modifyGenesis(cmd, func(cdc codec.Codec, appState map[string]json.RawMessage, _ *tmtypes.GenesisDoc) error {
+				stakingGenState := stakingtypes.GetGenesisStateFromAppState(cdc, appState)
+				stakingGenState.Params.UnbondingTime = period
+
+				return setModuleGenesis(cdc, appState, stakingtypes.ModuleName, stakingGenState)
+			})
+		},
+	}
+}
+
+func setGlobalFeeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-globalfee",
+		Short: "Set the params of the globalfee module given as flags",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return modifyGenesis(cmd, func(cdc codec.Codec, appState map[string]json.RawMessage, _ *tmtypes.GenesisDoc) error {
+				var globalFeeGenState globalfeetypes.GenesisState
+				if err := cdc.UnmarshalJSON(appState[globalfeetypes.ModuleName], &globalFeeGenState); err != nil {
+					return err
+				}
+				params := &globalFeeGenState.Params
+
+				if cmd.Flags().Changed(flagMinGasPrices) {
+					minGasPrices, _ := cmd.Flags().GetString(flagMinGasPrices)
+					prices, err := sdk.ParseDecCoins(minGasPrices)
+					if err != nil {
+						return fmt.Errorf("invalid minimum gas prices: %w", err)
+					}
+					params.MinimumGasPrices = prices
+				}
+				if cmd.Flags().Changed(flagBypassMinFeeMsgTypes) {
+					params.BypassMinFeeMsgTypes, _ = cmd.Flags().GetStringSlice(flagBypassMinFeeMsgTypes)
+				}
+				if cmd.Flags().Changed(flagMaxTotalBypassMinFeeMsgGasUsage) {
+					params.MaxTotalBypassMinFeeMsgGasUsage, _ = cmd.Flags().GetUint64(flagMaxTotalBypassMinFeeMsgGasUsage)
+				}
+				if err := params.ValidateBasic(); err != nil {
+					return err
+				}
+
+				return setModuleGenesis(cdc, appState, globalfeetypes.ModuleName, &globalFeeGenState)
+			})
+		},
+	}
+
+	cmd.Flags().String(flagMinGasPrices, "", "Global minimum gas prices, e.g. 0.0025uatom")
+	cmd.Flags().StringSlice(flagBypassMinFeeMsgTypes, nil, "Message types that can bypass the minimum fee, empty to bypass none")
+	cmd.Flags().Uint64(flagMaxTotalBypassMinFeeMsgGasUsage, 0, "Maximum gas usage of a transaction holding only bypass messages")
+
+	return cmd
+}
+
+func dropConsumersCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "drop-consumers",
+		Short: "Remove all the ICS consumer chains from the provider state",
+		Long: `Remove all the ICS consumer chains, their pending proposals and the validator
+keys assigned to them from the provider state. The unbondings put on hold by the
+provider for the consumers are released.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return modifyGenesis(cmd, func(cdc codec.Codec, appState map[string]json.RawMessage, _ *tmtypes.GenesisDoc) error {
+				var providerGenState providertypes.GenesisState
+				if err := cdc.UnmarshalJSON(appState[providertypes.ModuleName], &providerGenState); err != nil {
+					return err
+				}
+
+				// the valset update ids keep increasing and the params are kept
+				providerGenState = providertypes.GenesisState{
+					ValsetUpdateId:         providerGenState.ValsetUpdateId,
+					ValsetUpdateIdToHeight: providerGenState.ValsetUpdateIdToHeight,
+					Params:                 providerGenState.Params,
+				}
+				if err := setModuleGenesis(cdc, appState, providertypes.ModuleName, &providerGenState); err != nil {
+					return err
+				}
+
+				stakingGenState := stakingtypes.GetGenesisStateFromAppState(cdc, appState)
+				for i := range stakingGenState.Validators {
+					stakingGenState.Validators[i].UnbondingOnHoldRefCount = 0
+				}
+				for i := range stakingGenState.UnbondingDelegations {
+					for j := range stakingGenState.UnbondingDelegations[i].Entries {
+						stakingGenState.UnbondingDelegations[i].Entries[j].UnbondingOnHoldRefCount = 0
+					}
+				}
+				for i := range stakingGenState.Redelegations {
+					for j := range stakingGenState.Redelegations[i].Entries {
+						stakingGenState.Redelegations[i].Entries[j].UnbondingOnHoldRefCount = 0
+					}
+				}
+
+				return setModuleGenesis(cdc, appState, stakingtypes.ModuleName, stakingGenState)
+			})
+		},
+	}
+}
+
+func bumpBalanceCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "bump-balance [address] [coins]",
+		Short: "Add coins to the balance of an account, created if missing",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			addr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			coins, err := sdk.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return fmt.Errorf("failed to parse coins: %w", err)
+			}
+
+			return modifyGenesis(cmd, func(cdc codec.Codec, appState map[string]json.RawMessage, _ *tmtypes.GenesisDoc) error {
+				if err := ensureAccount(cdc, appState, addr); err != nil {
+					return err
+				}
+
+				return addBalance(cdc, appState, addr, coins)
+			})
+		},
+	}
+}
+
+func replaceValidatorCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "replace-validator",
+		Short: "Replace the validator set with a single validator using the local consensus key",
+		Long: `Replace the validator set with a single bonded validator, by default the one
+with the most voting power, which gets the consensus key of the node home and
+all the voting power, so that the local node produces blocks on its own.
+
+The other bonded validators are jailed for good and unbonded: their tokens are
+moved from the bonded pool to the not bonded pool, no tokens are minted. Their
+delegations are kept.
+
+When starting from a provider state with consumer chains, run drop-consumers as
+well.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			config := serverCtx.Config
+			homeDir, _ := cmd.Flags().GetString(flags.FlagHome)
+			config.SetRoot(homeDir)
+
+			bz, err := os.ReadFile(config.PrivValidatorKeyFile())
+			if err != nil {
+				return err
+			}
+			var key privval.FilePVKey
+			if err := tmjson.Unmarshal(bz, &key); err != nil {
+				return fmt.Errorf("invalid validator key file %s: %w", config.PrivValidatorKeyFile(), err)
+			}
+
+			operator, _ := cmd.Flags().GetString(flagValidator)
+
+			return modifyGenesis(cmd, func(cdc codec.Codec, appState map[string]json.RawMessage, genDoc *tmtypes.GenesisDoc) error {
+				return replaceValidator(cdc, appState, genDoc, operator, key.PubKey)
+			})
+		},
+	}
+
+	cmd.Flags().String(flagValidator, "", "Operator address of the validator to keep, defaults to the one with the most voting power")
+
+	return cmd
+}
+
+// replaceValidator replaces the validator set with the validator of the
+// operator, which gets the given consensus key. The other bonded validators
+// are jailed and unbonded, so that it holds all the voting power.
+func replaceValidator(cdc codec.Codec, appState map[string]json.RawMessage, genDoc *tmtypes.GenesisDoc, operator string, tmPubKey crypto.PubKey) error {
+	stakingGenState := stakingtypes.GetGenesisStateFromAppState(cdc, appState)
+
+	if operator == "" {
+		var maxPower int64 = -1
+		for _, power := range stakingGenState.LastValidatorPowers {
+			if power.Power > maxPower {
+				operator, maxPower = power.Address, power.Power
+			}
+		}
+		if operator == "" {
+			return fmt.Errorf("no bonded validator in genesis")
+		}
+	}
+
+	var validator *stakingtypes.Validator
+	for i := range stakingGenState.Validators {
+		if stakingGenState.Validators[i].OperatorAddress == operator {
+			validator = &stakingGenState.Validators[i]
+		}
+	}
+	if validator == nil {
+		return fmt.Errorf("validator %s not found", operator)
+	}
+	if !validator.IsBonded() {
+		return fmt.Errorf("validator %s is not bonded", operator)
+	}
+
+	var power *stakingtypes.LastValidatorPower
+	for i := range stakingGenState.LastValidatorPowers {
+		if stakingGenState.LastValidatorPowers[i].Address == operator {
+			power = &stakingGenState.LastValidatorPowers[i]
+		}
+	}
+	if power == nil || power.Power <= 0 {
+		return fmt.Errorf("validator %s has no voting power", operator)
+	}
+
+	// replace the consensus key
+	oldPubKey, err := validator.ConsPubKey()
+	if err != nil {
+		return err
+	}
+	newPubKey, err := cryptocodec.FromTmPubKeyInterface(tmPubKey)
+	if err != nil {
+		return err
+	}
+	validator.ConsensusPubkey, err = codectypes.NewAnyWithValue(newPubKey)
+	if err != nil {
+		return err
+	}
+
+	// jail and unbond the other bonded validators, a jailed validator cannot
+	// be bonded in genesis
+	unbondedTokens := sdkmath.ZeroInt()
+	jailedConsAddrs := make(map[string]bool)
+	for i := range stakingGenState.Validators {
+		val := &stakingGenState.Validators[i]
+		if val.OperatorAddress == operator || !val.IsBonded() {
+			continue
+		}
+		consAddr, err := val.GetConsAddr()
+		if err != nil {
+			return err
+		}
+		jailedConsAddrs[consAddr.String()] = true
+		val.Jailed = true
+		val.Status = stakingtypes.Unbonded
+		unbondedTokens = unbondedTokens.Add(val.Tokens)
+	}
+	stakingGenState.LastValidatorPowers = []stakingtypes.LastValidatorPower{*power}
+	stakingGenState.LastTotalPower = sdkmath.NewInt(power.Power)
+
+	if err := setModuleGenesis(cdc, appState, stakingtypes.ModuleName, stakingGenState); err != nil {
+		return err
+	}
+	if unbondedTokens.IsPositive() {
+		bondedPool := authtypes.NewModuleAddress(stakingtypes.BondedPoolName)
+		notBondedPool := authtypes.NewModuleAddress(stakingtypes.NotBondedPoolName)
+		if err := moveBalance(cdc, appState, bondedPool, notBondedPool, sdk.NewCoins(sdk.NewCoin(stakingGenState.Params.BondDenom, unbondedTokens))); err != nil {
+			return err
+		}
+	}
+
+	// move the signing info to the new consensus address, the jailed
+	// validators cannot be unjailed
+	oldConsAddr := sdk.ConsAddress(oldPubKey.Address()).String()
+	newConsAddr := sdk.ConsAddress(newPubKey.Address()).String()
+	var slashingGenState slashingtypes.GenesisState
+	if err := cdc.UnmarshalJSON(appState[slashingtypes.ModuleName], &slashingGenState); err != nil {
+		return err
+	}
+	for i, info := range slashingGenState.SigningInfos {
+		switch {
+		case info.Address == oldConsAddr:
+			slashingGenState.SigningInfos[i].Address = newConsAddr
+			slashingGenState.SigningInfos[i].ValidatorSigningInfo.Address = newConsAddr
+		case jailedConsAddrs[info.Address]:
+			slashingGenState.SigningInfos[i].ValidatorSigningInfo.JailedUntil = evidencetypes.DoubleSignJailEndTime
+		}
+	}
+	for i, missed := range slashingGenState.MissedBlocks {
+		if missed.Address == oldConsAddr {
+			slashingGenState.MissedBlocks[i].Address = newConsAddr
+		}
+	}
+	if err := setModuleGenesis(cdc, appState, slashingtypes.ModuleName, &slashingGenState); err != nil {
+		return err
+	}
+
+	// the validators of the genesis document must match the staking state
+	genDoc.Validators = []tmtypes.GenesisValidator{{
+		Address: tmPubKey.Address(),
+		PubKey:  tmPubKey,
+		Power:   power.Power,
+		Name:    validator.GetMoniker(),
+	}}
+
+	return nil
+}
+
+// ensureAccount adds a base account for the address to the auth genesis if it
+// does not exist yet. It gets the next account number, so that the accounts
+// keep their number when initialized in order.
+func ensureAccount(cdc codec.Codec, appState map[string]json.RawMessage, addr sdk.AccAddress) error {
+	authGenState := authtypes.GetGenesisStateFromAppState(cdc, appState)
+	accs, err := authtypes.UnpackAccounts(authGenState.Accounts)
+	if err != nil {
+		return fmt.Errorf("failed to get accounts from any: %w", err)
+	}
+	if accs.Contains(addr) {
+		return nil
+	}
+
+	var nextNumber uint64
+	for _, acc := range accs {
+		if acc.GetAccountNumber() >= nextNumber {
+			nextNumber = acc.GetAccountNumber() + 1
+		}
+	}
+	accs = append(accs, authtypes.NewBaseAccount(addr, nil, nextNumber, 0))
+
+	authGenState.Accounts, err = authtypes.PackAccounts(authtypes.SanitizeGenesisAccounts(accs))
+	if err != nil {
+		return fmt.Errorf("failed to convert accounts into any's: %w", err)
+	}
+
+	return setModuleGenesis(cdc, appState, authtypes.ModuleName, &authGenState)
+}
+
+// addBalance mints the coins to the balance of the address. The supply is
+// increased accordingly, unless it is left empty in genesis to be computed
+// from the balances.
+func addBalance(cdc codec.Codec, appState map[string]json.RawMessage, addr sdk.AccAddress, coins sdk.Coins) error {
+	bankGenState := banktypes.GetGenesisStateFromAppState(cdc, appState)
+
+	creditBalance(bankGenState, addr, coins)
+	if !bankGenState.Supply.Empty() {
+		bankGenState.Supply = bankGenState.Supply.Add(coins...)
+	}
+
+	return setModuleGenesis(cdc, appState, banktypes.ModuleName, bankGenState)
+}
+
+// moveBalance moves the coins from the balance of an address to the balance of
+// another one, the supply is unchanged.
+func moveBalance(cdc codec.Codec, appState map[string]json.RawMessage, from, to sdk.AccAddress, coins sdk.Coins) error {
+	bankGenState := banktypes.GetGenesisStateFromAppState(cdc, appState)
+
+	found := false
+	for i, balance := range bankGenState.Balances {
+		if balance.Address != from.String() {
+			continue
+		}
+		remaining, hasNeg := balance.Coins.SafeSub(coins...)
+		if hasNeg {
+			return fmt.Errorf("insufficient balance of %s: %s is smaller than %s", from, balance.Coins, coins)
+		}
+		bankGenState.Balances[i].Coins = remaining
+		found = true
+	}
+	if !found {
+		return fmt.Errorf("no balance for %s", from)
+	}
+	creditBalance(bankGenState, to, coins)
+
+	return setModuleGenesis(cdc, appState, banktypes.ModuleName, bankGenState)
+}
+
+// creditBalance adds the coins to the balance of the address, created if
+// missing.
+func creditBalance(bankGenState *banktypes.GenesisState, addr sdk.AccAddress, coins sdk.Coins) {
+	for i, balance := range bankGenState.Balances {
+		if balance.Address == addr.String() {
+			bankGenState.Balances[i].Coins = balance.Coins.Add(coins...)
+			return
+		}
+	}
+	bankGenState.Balances = append(bankGenState.Balances, banktypes.Balance{Address: addr.String(), Coins: coins})
+	bankGenState.Balances = banktypes.SanitizeGenesisBalances(bankGenState.Balances)
+}
+
+// modifyGenesis applies the change to the genesis file of the node home.
+func modifyGenesis(cmd *cobra.Command, modify func(cdc codec.Codec, appState map[string]json.RawMessage, genDoc *tmtypes.GenesisDoc) error) error {
+	clientCtx := client.GetClientContextFromCmd(cmd)
+	serverCtx := server.GetServerContextFromCmd(cmd)
+	config := serverCtx.Config
+	homeDir, _ := cmd.Flags().GetString(flags.FlagHome)
+	config.SetRoot(homeDir)
+
+	genFile := config.GenesisFile()
+	appState, genDoc, err := genutiltypes.GenesisStateFromGenFile(genFile)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal genesis state: %w", err)
+	}
+
+	if err := modify(clientCtx.Codec, appState, genDoc); err != nil {
+		return err
+	}
+
+	appStateJSON, err := json.Marshal(appState)
+	if err != nil {
+		return fmt.Errorf("failed to marshal application genesis state: %w", err)
+	}
+	genDoc.AppState = appStateJSON
+
+	return genutil.ExportGenesisFile(genDoc, genFile)
+}
+
+func setModuleGenesis(cdc codec.Codec, appState map[string]json.RawMessage, module string, genState codec.ProtoMarshaler) error {
+	bz, err := cdc.MarshalJSON(genState)
+	if err != nil {
+		return fmt.Errorf("failed to marshal %s genesis state: %w", module, err)
+	}
+	appState[module] = bz
+
+	return nil
+}
+
+func parsePeriod(s string) (time.Duration, error) {
+	period, err := time.ParseDuration(s)
+	if err != nil {
+		return 0, err
+	}
+	if period <= 0 {
+		return 0, fmt.Errorf("period must be positive, got %s", period)
+	}
+
+	return period, nil
+}
@@ -0,0 +1,113 @@
+package cmd
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	dbm "github.com/cometbft/cometbft-db"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	"github.com/cometbft/cometbft/libs/log"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	tmtypes "github.com/cometbft/cometbft/types"
+
+	"github.com/cosmos/cosmos-sdk/testutil/mock"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	gaia "github.com/cosmos/gaia/v17/app"
+	"github.com/cosmos/gaia/v17/app/helpers"
+	"github.com/cosmos/gaia/v17/app/upgrades"
+)
+
+func TestReplaceValidator(t *testing.T) {
+	validators := make([]*tmtypes.Validator, 0, 3)
+	for i := 0; i < 3; i++ {
+		pubKey, err := mock.NewPV().GetPubKey()
+		require.NoError(t, err)
+		validators = append(validators, tmtypes.NewValidator(pubKey, 1))
+	}
+	valSet := tmtypes.NewValidatorSet(validators)
+	delegator := authtypes.NewBaseAccount(sdk.AccAddress("delegator"), nil, 0, 0)
+	balance := banktypes.Balance{
+		Address: delegator.GetAddress().String(),
+		Coins:   sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100000000000000))),
+	}
+	gaiaApp := helpers.SetupWithGenesisValSet(t, valSet, []authtypes.GenesisAccount{delegator}, balance)
+	cdc := gaiaApp.AppCodec()
+
+	exported, err := gaiaApp.ExportAppStateAndValidators(false, []string{}, []string{})
+	require.NoError(t, err)
+	var appState map[string]json.RawMessage
+	require.NoError(t, json.Unmarshal(exported.AppState, &appState))
+	genDoc := &tmtypes.GenesisDoc{
+		InitialHeight: exported.Height + 1,
+		Validators:    exported.Validators,
+	}
+	supply := banktypes.GetGenesisStateFromAppState(cdc, appState).Supply
+
+	operator := sdk.ValAddress(valSet.Validators[1].Address).String()
+	pubKey := ed25519.GenPrivKey().PubKey()
+	require.ErrorContains(t, replaceValidator(cdc, appState, genDoc, sdk.ValAddress("unknown").String(), pubKey), "not found")
+	require.NoError(t, replaceValidator(cdc, appState, genDoc, operator, pubKey))
+
+	// the validator holds all the voting power, the others are jailed and unbonded
+	stakingGenState := stakingtypes.GetGenesisStateFromAppState(cdc, appState)
+	require.Len(t, genDoc.Validators, 1)
+	require.Equal(t, pubKey, genDoc.Validators[0].PubKey)
+	require.Equal(t, []stakingtypes.LastValidatorPower{{Address: operator, Power: 1}}, stakingGenState.LastValidatorPowers)
+	require.Equal(t, stakingGenState.LastTotalPower.Int64(), genDoc.Validators[0].Power)
+	for _, val := range stakingGenState.Validators {
+		if val.OperatorAddress == operator {
+			require.True(t, val.IsBonded())
+			continue
+		}
+		require.True(t, val.IsJailed())
+		require.True(t, val.IsUnbonded())
+	}
+	// no tokens are minted
+	require.Equal(t, supply, banktypes.GetGenesisStateFromAppState(cdc, appState).Supply)
+
+	addr := sdk.AccAddress("new-account")
+	require.NoError(t, ensureAccount(cdc, appState, addr))
+	require.NoError(t, addBalance(cdc, appState, addr, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000))))
+
+	// a fresh node starts from the modified genesis
+	appStateJSON, err := json.Marshal(appState)
+	require.NoError(t, err)
+	updates := make([]abci.ValidatorUpdate, 0, len(genDoc.Validators))
+	for _, val := range genDoc.Validators {
+		updates = append(updates, abci.UpdateValidator(val.PubKey.Bytes(), val.Power, ed25519.KeyType))
+	}
+
+	newApp := gaia.NewGaiaApp(log.NewNopLogger(), dbm.NewMemDB(), nil, true, map[int64]bool{}, gaia.DefaultNodeHome, gaia.RegisterEncodingConfig(), helpers.EmptyAppOptions{})
+	res := newApp.InitChain(abci.RequestInitChain{
+		ChainId:         genDoc.ChainID,
+		InitialHeight:   genDoc.InitialHeight,
+		Validators:      updates,
+		ConsensusParams: helpers.DefaultConsensusParams,
+		AppStateBytes:   appStateJSON,
+	})
+	require.Equal(t, updates, res.Validators)
+	newApp.Commit()
+
+	ctx := newApp.NewContext(true, tmproto.Header{Height: newApp.LastBlockHeight()})
+	require.NoError(t, upgrades.CheckInvariants(ctx, &newApp.AppKeepers))
+	// the jailed validators do not get back in the validator set
+	height := newApp.LastBlockHeight() + 1
+	newApp.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: height}})
+	require.Empty(t, newApp.EndBlock(abci.RequestEndBlock{Height: height}).ValidatorUpdates)
+	require.Equal(t, "1000"+sdk.DefaultBondDenom, newApp.BankKeeper.GetAllBalances(ctx, addr).String())
+	require.NotNil(t, newApp.AccountKeeper.GetAccount(ctx, addr))
+
+	// a supply left empty in genesis is computed from the balances
+	bankGenState := banktypes.GetGenesisStateFromAppState(cdc, appState)
+	bankGenState.Supply = nil
+	require.NoError(t, setModuleGenesis(cdc, appState, banktypes.ModuleName, bankGenState))
+	require.NoError(t, addBalance(cdc, appState, addr, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000))))
+	require.Empty(t, banktypes.GetGenesisStateFromAppState(cdc, appState).Supply)
+}
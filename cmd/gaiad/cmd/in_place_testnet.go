@@ -0,0 +1,281 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	dbm "github.com/cometbft/cometbft-db"
+	tmcfg "github.com/cometbft/cometbft/config"
+	cs "github.com/cometbft/cometbft/consensus"
+	tmjson "github.com/cometbft/cometbft/libs/json"
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cometbft/cometbft/node"
+	"github.com/cometbft/cometbft/privval"
+	tmstate "github.com/cometbft/cometbft/proto/tendermint/state"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sm "github.com/cometbft/cometbft/state"
+	"github.com/cometbft/cometbft/store"
+	tmtypes "github.com/cometbft/cometbft/types"
+	tmtime "github.com/cometbft/cometbft/types/time"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	"github.com/cosmos/cosmos-sdk/server"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	gaia "github.com/cosmos/gaia/v17/app"
+)
+
+const (
+	flagAccountsToFund = "accounts-to-fund"
+	flagFundAmount     = "fund-amount"
+	flagVotingPeriod   = "voting-period"
+)
+
+// inPlaceTestnetCmd returns a start command that turns the state of the node
+// home, e.g. a mainnet node, into a testnet run by the local node alone.
+func inPlaceTestnetCmd(ac appCreator, defaultNodeHome string) *cobra.Command {
+	var (
+		testnetCfg gaia.InPlaceTestnetConfig
+		config     *tmcfg.Config
+		pv         *privval.FilePV
+	)
+
+	cmd := server.StartCmd(func(logger log.Logger, db dbm.DB, traceStore io.Writer, appOpts servertypes.AppOptions) servertypes.Application {
+		gaiaApp := ac.newApp(logger, db, traceStore, appOpts).(*gaia.GaiaApp)
+
+		valSet, err := gaiaApp.InitForInPlaceTestnet(testnetCfg)
+		if err != nil {
+			panic(fmt.Errorf("failed to modify the app state: %w", err))
+		}
+
+		if err := updateConsensusState(config, testnetCfg.ChainID, pv, valSet, gaiaApp.LastBlockHeight()); err != nil {
+			panic(fmt.Errorf("failed to modify the consensus state: %w", err))
+		}
+
+		return gaiaApp
+	}, defaultNodeHome)
+
+	cmd.Use = "in-place-testnet [new-chain-id] [validator-operator]"
+	cmd.Short = "Start a single validator testnet from the state of the node"
+	cmd.Long = `Turn the state of the node home, e.g. a synced mainnet node, into a testnet run
+by the local node alone, and start the node.
+
+The given validator takes the consensus key of the node home and gets more than
+2/3 of the voting power through a minted self-delegation. The other validators
+are jailed and leave the validator set after two blocks. The chain ID is
+changed, the voting period of governance proposals is shortened and the test
+accounts are funded with minted coins.
+
+The node home is modified in place and cannot join its original network
+anymore: run it on a copy. Once started, the testnet is run with the start
+command.`
+	cmd.Example = "gaiad in-place-testnet testnet-1 cosmosvaloper1... --accounts-to-fund cosmos1...,cosmos1..."
+	cmd.Args = cobra.ExactArgs(2)
+
+	preRunE := cmd.PreRunE
+	cmd.PreRunE = func(cmd *cobra.Command, args []string) error {
+		if err := preRunE(cmd, args); err != nil {
+			return err
+		}
+		serverCtx := server.GetServerContextFromCmd(cmd)
+		config = serverCtx.Config
+
+		operator, err := sdk.ValAddressFromBech32(args[1])
+		if err != nil {
+			return err
+		}
+		votingPeriod, _ := cmd.Flags().GetDuration(flagVotingPeriod)
+		if votingPeriod <= 0 {
+			return fmt.Errorf("voting period must be positive, got %s", votingPeriod)
+		}
+		fundAmount, _ := cmd.Flags().GetString(flagFundAmount)
+		coins, err := sdk.ParseCoinsNormalized(fundAmount)
+		if err != nil {
+			return fmt.Errorf("invalid fund amount: %w", err)
+		}
+		addrs, _ := cmd.Flags().GetStringSlice(flagAccountsToFund)
+		accounts := make([]sdk.AccAddress, 0, len(addrs))
+		for _, addr := range addrs {
+			acc, err := sdk.AccAddressFromBech32(addr)
+			if err != nil {
+				return err
+			}
+			accounts = append(accounts, acc)
+		}
+
+		pv = privval.LoadOrGenFilePV(config.PrivValidatorKeyFile(), config.PrivValidatorStateFile())
+		pubKey, err := cryptocodec.FromTmPubKeyInterface(pv.Key.PubKey)
+		if err != nil {
+			return err
+		}
+
+		testnetCfg = gaia.InPlaceTestnetConfig{
+			ChainID:         args[0],
+			Operator:        operator,
+			ConsensusPubKey: pubKey,
+			VotingPeriod:    votingPeriod,
+			AccountsToFund:  accounts,
+			FundAmount:      coins,
+		}
+
+		// the app runs under the new chain ID
+		serverCtx.Viper.Set(flags.FlagChainID, testnetCfg.ChainID)
+		// the peers of the original network are not dialed, and the node does
+		// not wait for peers to sync blocks from while the other validators are
+		// still in the validator set
+		config.P2P.Seeds = ""
+		config.P2P.PersistentPeers = ""
+		config.P2P.PexReactor = false
+		config.BlockSyncMode = false
+
+		return nil
+	}
+
+	cmd.Flags().StringSlice(flagAccountsToFund, nil, "Accounts funded with the fund amount")
+	cmd.Flags().String(flagFundAmount, "1000000000000uatom", "Coins minted to each account to fund")
+	cmd.Flags().Duration(flagVotingPeriod, time.Minute, "Voting period of governance proposals")
+	addModuleInitFlags(cmd)
+
+	return cmd
+}
+
+// updateConsensusState rewrites the consensus state of the node so that the
+// last block is committed by the local validator alone, under the new chain
+// ID, and the next blocks are validated by the given validator set.
+func updateConsensusState(config *tmcfg.Config, chainID string, pv *privval.FilePV, valSet *tmtypes.ValidatorSet, appHeight int64) error {
+	blockStoreDB, err := node.DefaultDBProvider(&node.DBContext{ID: "blockstore", Config: config})
+	if err != nil {
+		return err
+	}
+	blockStore := store.NewBlockStore(blockStoreDB)
+	defer blockStore.Close()
+
+	stateDB, err := node.DefaultDBProvider(&node.DBContext{ID: "state", Config: config})
+	if err != nil {
+		return err
+	}
+	defer stateDB.Close()
+	stateStore := sm.NewStore(stateDB, sm.StoreOptions{DiscardABCIResponses: config.Storage.DiscardABCIResponses})
+
+	state, genDoc, err := node.LoadStateFromDBOrGenesisDocProvider(stateDB, node.DefaultGenesisDocProviderFunc(config))
+	if err != nil {
+		return err
+	}
+
+	// a block saved but not yet applied when the node stopped is dropped
+	if blockStore.Height() == state.LastBlockHeight+1 {
+		if err := blockStore.DeleteLatestBlock(); err != nil {
+			return err
+		}
+	}
+	height := state.LastBlockHeight
+	if height == 0 {
+		return fmt.Errorf("the node has no block")
+	}
+	if blockStore.Height() != height || appHeight != height {
+		return fmt.Errorf(
+			"the app (height %d), the block store (height %d) and the consensus state (height %d) are out of sync, run the node on its original network first",
+			appHeight, blockStore.Height(), height,
+		)
+	}
+
+	// the last block is committed by the local validator alone
+	idx, _ := valSet.GetByAddress(pv.GetAddress())
+	if idx < 0 {
+		return fmt.Errorf("the local validator is not in the validator set")
+	}
+	vote := &tmtypes.Vote{
+		Type:             tmproto.PrecommitType,
+		Height:           height,
+		Round:            0,
+		BlockID:          state.LastBlockID,
+		Timestamp:        tmtime.Now(),
+		ValidatorAddress: pv.GetAddress(),
+		ValidatorIndex:   idx,
+	}
+	// the key may have signed later heights, e.g. on another testnet
+	pv.LastSignState.Height = height
+	pv.LastSignState.Round = 0
+	pv.LastSignState.Step = 0
+	pv.LastSignState.Signature = nil
+	pv.LastSignState.SignBytes = nil
+	voteProto := vote.ToProto()
+	if err := pv.SignVote(chainID, voteProto); err != nil {
+		return err
+	}
+	vote.Signature = voteProto.Signature
+	vote.Timestamp = voteProto.Timestamp
+
+	seenCommit := &tmtypes.Commit{
+		Height:     height,
+		Round:      vote.Round,
+		BlockID:    state.LastBlockID,
+		Signatures: make([]tmtypes.CommitSig, valSet.Size()),
+	}
+	for i := range seenCommit.Signatures {
+		seenCommit.Signatures[i] = tmtypes.NewCommitSigAbsent()
+	}
+	seenCommit.Signatures[idx] = vote.CommitSig()
+	if err := blockStore.SaveSeenCommit(height, seenCommit); err != nil {
+		return err
+	}
+
+	// replace the validator sets, the stored ones included
+	state.ChainID = chainID
+	state.LastValidators = valSet.Copy()
+	state.Validators = valSet.Copy()
+	state.NextValidators = valSet.Copy()
+	state.LastHeightValidatorsChanged = height
+	valSetProto, err := valSet.ToProto()
+	if err != nil {
+		return err
+	}
+	valInfo, err := (&tmstate.ValidatorsInfo{ValidatorSet: valSetProto, LastHeightChanged: height}).Marshal()
+	if err != nil {
+		return err
+	}
+	for _, h := range []int64{height, height + 1} {
+		if err := stateDB.Set([]byte(fmt.Sprintf("validatorsKey:%v", h)), valInfo); err != nil {
+			return err
+		}
+	}
+	if err := stateStore.Save(state); err != nil {
+		return err
+	}
+
+	// the genesis document holds the chain ID too
+	genDoc.ChainID = chainID
+	bz, err := tmjson.Marshal(genDoc)
+	if err != nil {
+		return err
+	}
+	if err := stateDB.SetSync([]byte("genesisDoc"), bz); err != nil {
+		return err
+	}
+	if err := genDoc.SaveAs(config.GenesisFile()); err != nil {
+		return err
+	}
+
+	// the consensus messages of the original network are not replayed
+	walFile := config.Consensus.WalFile()
+	if err := os.RemoveAll(filepath.Dir(walFile)); err != nil {
+		return err
+	}
+	wal, err := cs.NewWAL(walFile)
+	if err != nil {
+		return err
+	}
+	if err := wal.Start(); err != nil {
+		return err
+	}
+	defer wal.Stop() //nolint:errcheck
+
+	return wal.WriteSync(cs.EndHeightMessage{Height: height})
+}
@@ -107,6 +107,8 @@ func initAppConfig() (string, interface{}) {
 	// Embed additional configurations
 	type CustomAppConfig struct {
 		serverconfig.Config
+
+		Gaia gaia.GaiaConfig `mapstructure:"gaia"`
 	}
 
 	// Can optionally overwrite the SDK's default server config.
@@ -116,9 +118,10 @@ func initAppConfig() (string, interface{}) {
 
 	customAppConfig := CustomAppConfig{
 		Config: *srvCfg,
+		Gaia:   gaia.DefaultGaiaConfig(),
 	}
 
-	defaultAppTemplate := serverconfig.DefaultConfigTemplate
+	defaultAppTemplate := serverconfig.DefaultConfigTemplate + gaia.GaiaConfigTemplate
 
 	return defaultAppTemplate, customAppConfig
 }
@@ -133,18 +136,24 @@ func initRootCmd(rootCmd *cobra.Command, encodingConfig params.EncodingConfig) {
 		genutilcli.InitCmd(gaia.ModuleBasics, gaia.DefaultNodeHome),
 		tmcli.NewCompletionCmd(rootCmd, true),
 		NewTestnetCmd(gaia.ModuleBasics, banktypes.GenesisBalancesIterator{}),
-		addDebugCommands(debug.Cmd()),
+		addDebugCommands(debug.Cmd(), encodingConfig),
 		config.Cmd(),
 		pruning.PruningCmd(ac.newApp),
 		snapshot.Cmd(ac.newApp),
 	)
 
 	server.AddCommands(rootCmd, gaia.DefaultNodeHome, ac.newApp, ac.appExport, addModuleInitFlags)
+	extendExportCmd(rootCmd, ac)
+	rootCmd.AddCommand(inPlaceTestnetCmd(ac, gaia.DefaultNodeHome))
 
 	// add keybase, auxiliary RPC, query, and tx child commands
 	rootCmd.AddCommand(
 		rpc.StatusCommand(),
-		genesisCommand(encodingConfig),
+		genesisCommand(encodingConfig,
+			AddGenesisAccountCmd(gaia.DefaultNodeHome),
+			BulkAddGenesisAccountCmd(gaia.DefaultNodeHome),
+			GenesisModifyCmd(gaia.DefaultNodeHome),
+		),
 		queryCommand(),
 		txCommand(),
 		keys.Commands(gaia.DefaultNodeHome),
@@ -163,6 +172,12 @@ func genesisCommand(encodingConfig params.EncodingConfig, cmds ...*cobra.Command
 	cmd := genutilcli.GenesisCoreCommand(encodingConfig.TxConfig, gaia.ModuleBasics, gaia.DefaultNodeHome)
 
 	for _, subCmd := range cmds {
+		// the app's commands replace the SDK ones of the same name
+		for _, sdkCmd := range cmd.Commands() {
+			if sdkCmd.Name() == subCmd.Name() {
+				cmd.RemoveCommand(sdkCmd)
+			}
+		}
 		cmd.AddCommand(subCmd)
 	}
 	return cmd
@@ -212,6 +227,7 @@ func txCommand() *cobra.Command {
 		authcmd.GetEncodeCommand(),
 		authcmd.GetDecodeCommand(),
 		authcmd.GetAuxToFeeCommand(),
+		EstimateFeeCommand(),
 	)
 
 	gaia.ModuleBasics.AddTxCommands(cmd)
@@ -311,17 +327,34 @@ func (a appCreator) appExport(
 	appOpts servertypes.AppOptions,
 	modulesToExport []string,
 ) (servertypes.ExportedApp, error) {
+	gaiaApp, err := a.exportApp(logger, db, traceStore, height, appOpts)
+	if err != nil {
+		return servertypes.ExportedApp{}, err
+	}
+
+	return gaiaApp.ExportAppStateAndValidators(forZeroHeight, jailAllowedAddrs, modulesToExport)
+}
+
+// exportApp returns the app to export the state from, loaded at the given
+// height, -1 being the latest height.
+func (a appCreator) exportApp(
+	logger log.Logger,
+	db dbm.DB,
+	traceStore io.Writer,
+	height int64,
+	appOpts servertypes.AppOptions,
+) (*gaia.GaiaApp, error) {
 	var gaiaApp *gaia.GaiaApp
 
 	homePath, ok := appOpts.Get(flags.FlagHome).(string)
 	if !ok || homePath == "" {
-		return servertypes.ExportedApp{}, errors.New("application home is not set")
+		return nil, errors.New("application home is not set")
 	}
 
 	// InvCheckPeriod
 	viperAppOpts, ok := appOpts.(*viper.Viper)
 	if !ok {
-		return servertypes.ExportedApp{}, errors.New("appOpts is not viper.Viper")
+		return nil, errors.New("appOpts is not viper.Viper")
 	}
 	// overwrite the FlagInvCheckPeriod
 	viperAppOpts.Set(server.FlagInvCheckPeriod, 1)
@@ -345,9 +378,9 @@ func (a appCreator) appExport(
 
 	if height != -1 {
 		if err := gaiaApp.LoadHeight(height); err != nil {
-			return servertypes.ExportedApp{}, err
+			return nil, err
 		}
 	}
 
-	return gaiaApp.ExportAppStateAndValidators(forZeroHeight, jailAllowedAddrs, modulesToExport)
+	return gaiaApp, nil
 }
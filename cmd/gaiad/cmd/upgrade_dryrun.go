@@ -0,0 +1,185 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+	"github.com/syndtr/goleveldb/leveldb/opt"
+
+	dbm "github.com/cometbft/cometbft-db"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	tmstore "github.com/cometbft/cometbft/store"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	gaia "github.com/cosmos/gaia/v17/app"
+	"github.com/cosmos/gaia/v17/app/params"
+)
+
+const (
+	flagDryRunHeight      = "height"
+	flagMaxBalanceChanges = "max-balance-changes"
+)
+
+// UpgradeDryRunCommand returns the upgrade-dry-run cobra Command.
+func UpgradeDryRunCommand(encodingConfig params.EncodingConfig) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "upgrade-dry-run [upgrade-name]",
+		Short: "Run an upgrade handler against the local state without committing",
+		Long: `Load the local application state at the given height, apply the store upgrades
+of the named upgrade in memory and run its upgrade handler, including the module
+migrations, as if the upgrade happened at the next block. The databases are
+opened read-only, so nothing is written to them, which is only supported by the
+goleveldb backend. The node must be stopped.
+
+Prints a summary of the changes made by the upgrade: module versions, total
+supply, account balances and module params.
+
+Example:
+	gaiad debug upgrade-dry-run v17 --height 18000000
+	`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			config := serverCtx.Config
+
+			upgrade, err := gaia.GetUpgrade(args[0])
+			if err != nil {
+				return err
+			}
+			height, err := cmd.Flags().GetInt64(flagDryRunHeight)
+			if err != nil {
+				return err
+			}
+			maxBalanceChanges, err := cmd.Flags().GetInt(flagMaxBalanceChanges)
+			if err != nil {
+				return err
+			}
+
+			db, err := openReadOnlyDB("application", server.GetAppDBBackend(serverCtx.Viper), filepath.Join(config.RootDir, "data"))
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			if height == 0 {
+				height = rootmulti.GetLatestVersion(db)
+			}
+
+			blockStoreDB, err := openReadOnlyDB("blockstore", dbm.BackendType(config.DBBackend), config.DBDir())
+			if err != nil {
+				return err
+			}
+			defer blockStoreDB.Close()
+
+			meta := tmstore.NewBlockStore(blockStoreDB).LoadBlockMeta(height)
+			if meta == nil {
+				return fmt.Errorf("block %d not found in the block store", height)
+			}
+
+			app := gaia.NewGaiaApp(
+				serverCtx.Logger, db, nil, false, map[int64]bool{}, config.RootDir, encodingConfig, serverCtx.Viper,
+				// the fast node index would be written to the database on load
+				baseapp.SetIAVLDisableFastNode(true),
+			)
+
+			storeUpgrades, err := pendingStoreUpgrades(app.CommitMultiStore(), height, upgrade.StoreUpgrades)
+			if err != nil {
+				return err
+			}
+
+			cms := app.CommitMultiStore()
+			if err := cms.LoadVersionAndUpgrade(height, &storeUpgrades); err != nil {
+				return fmt.Errorf("failed to load version %d: %w", height, err)
+			}
+
+			// the upgrade is applied at the beginning of the next block
+			header := tmproto.Header{
+				ChainID: meta.Header.ChainID,
+				Height:  height + 1,
+				Time:    meta.Header.Time,
+			}
+			ctx := sdk.NewContext(cms.CacheMultiStore(), header, false, serverCtx.Logger)
+
+			result, err := app.DryRunUpgrade(ctx, upgrade.UpgradeName)
+			if err != nil {
+				return err
+			}
+
+			if maxBalanceChanges > 0 && len(result.Balances) > maxBalanceChanges {
+				cmd.PrintErrf("showing %d of %d balance changes\n", maxBalanceChanges, len(result.Balances))
+				result.Balances = result.Balances[:maxBalanceChanges]
+			}
+
+			out, err := json.MarshalIndent(result, "", "  ")
+			if err != nil {
+				return err
+			}
+			cmd.Println(string(out))
+
+			return nil
+		},
+	}
+
+	cmd.Flags().Int64(flagDryRunHeight, 0, "Height of the state to run the upgrade against, defaults to the latest height")
+	cmd.Flags().Int(flagMaxBalanceChanges, 100, "Maximum number of balance changes to print, 0 prints all")
+
+	return cmd
+}
+
+// pendingStoreUpgrades returns the store upgrades that are not yet applied at
+// the given height, e.g. added stores that already exist are skipped.
+func pendingStoreUpgrades(cms storetypes.CommitMultiStore, height int64, upgrades storetypes.StoreUpgrades) (storetypes.StoreUpgrades, error) {
+	rs, ok := cms.(*rootmulti.Store)
+	if !ok {
+		return upgrades, nil
+	}
+
+	commitInfo, err := rs.GetCommitInfo(height)
+	if err != nil {
+		return upgrades, fmt.Errorf("failed to load commit info of version %d: %w", height, err)
+	}
+	stores := make(map[string]struct{}, len(commitInfo.StoreInfos))
+	for _, info := range commitInfo.StoreInfos {
+		stores[info.Name] = struct{}{}
+	}
+	exists := func(name string) bool {
+		_, ok := stores[name]
+		return ok
+	}
+
+	var pending storetypes.StoreUpgrades
+	for _, name := range upgrades.Added {
+		if !exists(name) {
+			pending.Added = append(pending.Added, name)
+		}
+	}
+	for _, name := range upgrades.Deleted {
+		if exists(name) {
+			pending.Deleted = append(pending.Deleted, name)
+		}
+	}
+	for _, rename := range upgrades.Renamed {
+		if exists(rename.OldKey) {
+			pending.Renamed = append(pending.Renamed, rename)
+		}
+	}
+
+	return pending, nil
+}
+
+// openReadOnlyDB opens the database with the given name read-only. The other
+// backends than goleveldb are refused, as they would be opened read-write.
+func openReadOnlyDB(name string, backend dbm.BackendType, dir string) (dbm.DB, error) {
+	if backend != dbm.GoLevelDBBackend {
+		return nil, fmt.Errorf("the %s database cannot be opened read-only with the %s backend, only %s is supported", name, backend, dbm.GoLevelDBBackend)
+	}
+
+	return dbm.NewGoLevelDBWithOpts(name, dir, &opt.Options{ReadOnly: true})
+}
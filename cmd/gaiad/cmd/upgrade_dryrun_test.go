@@ -0,0 +1,117 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	dbm "github.com/cometbft/cometbft-db"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/libs/log"
+	tmstore "github.com/cometbft/cometbft/store"
+	tmtypes "github.com/cometbft/cometbft/types"
+
+	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+
+	gaia "github.com/cosmos/gaia/v17/app"
+	gaiahelpers "github.com/cosmos/gaia/v17/app/helpers"
+	"github.com/cosmos/gaia/v17/app/keepers"
+	"github.com/cosmos/gaia/v17/app/upgrades"
+)
+
+func TestUpgradeDryRunCommand(t *testing.T) {
+	minted := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000))
+
+	registered := gaia.Upgrades
+	t.Cleanup(func() { gaia.Upgrades = registered })
+	gaia.Upgrades = append([]upgrades.Upgrade{{
+		UpgradeName: "dry-run",
+		CreateUpgradeHandler: func(mm *module.Manager, _ module.Configurator, keepers *keepers.AppKeepers) upgradetypes.UpgradeHandler {
+			return func(ctx sdk.Context, _ upgradetypes.Plan, _ module.VersionMap) (module.VersionMap, error) {
+				return mm.GetVersionMap(), keepers.BankKeeper.MintCoins(ctx, minttypes.ModuleName, minted)
+			}
+		},
+		SupplyChange: func(sdk.Context) (sdk.Coins, sdk.Coins, error) {
+			return minted, nil, nil
+		},
+	}}, registered...)
+
+	home := t.TempDir()
+	dataDir := filepath.Join(home, "data")
+	encodingConfig := gaia.RegisterEncodingConfig()
+
+	// commit the genesis state of a test app at height 1
+	exported, err := gaiahelpers.Setup(t).ExportAppStateAndValidators(false, nil, nil)
+	require.NoError(t, err)
+	db, err := dbm.NewGoLevelDB("application", dataDir)
+	require.NoError(t, err)
+	app := gaia.NewGaiaApp(log.NewNopLogger(), db, nil, true, map[int64]bool{}, home, encodingConfig, gaiahelpers.EmptyAppOptions{})
+	app.InitChain(abci.RequestInitChain{
+		ConsensusParams: gaiahelpers.DefaultConsensusParams,
+		AppStateBytes:   exported.AppState,
+	})
+	app.Commit()
+	require.NoError(t, db.Close())
+
+	blockStoreDB, err := dbm.NewGoLevelDB("blockstore", dataDir)
+	require.NoError(t, err)
+	block := tmtypes.MakeBlock(1, nil, &tmtypes.Commit{}, nil)
+	block.ChainID = "gaia-test"
+	block.Time = time.Now().UTC()
+	block.ProposerAddress = make([]byte, 20)
+	parts, err := block.MakePartSet(tmtypes.BlockPartSizeBytes)
+	require.NoError(t, err)
+	tmstore.NewBlockStore(blockStoreDB).SaveBlock(block, parts, &tmtypes.Commit{Height: 1})
+	require.NoError(t, blockStoreDB.Close())
+
+	before := dumpDB(t, dataDir, "application")
+
+	var out bytes.Buffer
+	run := func(backend string) error {
+		serverCtx := server.NewDefaultContext()
+		serverCtx.Config.SetRoot(home)
+		serverCtx.Viper.Set("app-db-backend", backend)
+
+		cmd := UpgradeDryRunCommand(encodingConfig)
+		cmd.SetArgs([]string{"dry-run"})
+		cmd.SetOut(&out)
+		return cmd.ExecuteContext(context.WithValue(context.Background(), server.ServerContextKey, serverCtx))
+	}
+
+	require.NoError(t, run(string(dbm.GoLevelDBBackend)))
+	require.Contains(t, out.String(), `"upgrade": "dry-run"`)
+	// the database is left unchanged
+	require.Equal(t, before, dumpDB(t, dataDir, "application"))
+
+	// the backends that cannot be opened read-only are refused
+	require.ErrorContains(t, run(string(dbm.MemDBBackend)), "cannot be opened read-only with the memdb backend")
+}
+
+// dumpDB returns all the key-value pairs of the database.
+func dumpDB(t *testing.T, dir, name string) map[string]string {
+	t.Helper()
+
+	db, err := dbm.NewGoLevelDB(name, dir)
+	require.NoError(t, err)
+	defer db.Close()
+
+	it, err := db.Iterator(nil, nil)
+	require.NoError(t, err)
+	defer it.Close()
+
+	kvs := make(map[string]string)
+	for ; it.Valid(); it.Next() {
+		kvs[string(it.Key())] = string(it.Value())
+	}
+	require.NoError(t, it.Error())
+
+	return kvs
+}
@@ -0,0 +1,229 @@
+package mempool
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/cometbft/cometbft/crypto"
+
+	errorsmod "cosmossdk.io/errors"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkmempool "github.com/cosmos/cosmos-sdk/types/mempool"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing"
+
+	gaiaerrors "github.com/cosmos/gaia/v17/types/errors"
+)
+
+var _ sdkmempool.Mempool = (*Mempool)(nil)
+
+// Config defines the limits of the mempool, a zero limit is no limit.
+type Config struct {
+	// MaxTxs is the maximum number of txs in the mempool. Beyond, the tx with
+	// the lowest priority is evicted for a tx with a higher priority. The
+	// evicted tx is only removed from the app mempool: it stays in the
+	// CometBFT mempool, and may be gossiped and included by other proposers,
+	// until it expires, see the ttl-num-blocks setting of CometBFT.
+	MaxTxs int
+	// MaxTxsPerSender is the maximum number of pending txs of a sender.
+	MaxTxsPerSender int
+}
+
+// Mempool is an app-side mempool that orders the txs by priority, as set by
+// the ante handler, and by nonce for each sender, see the SDK
+// PriorityNonceMempool. It limits the number of pending txs of each sender
+// and evicts the lowest priority txs when full.
+//
+// The sender of a tx is its first signer. The txs are wrapped for the
+// PriorityNonceMempool, which identifies the sender by the public key of the
+// first signature, and this key is not set once the account has a public key
+// on chain.
+//
+// Like the SDK mempools, it is not safe for concurrent use: the ABCI calls of
+// CometBFT are serialized.
+type Mempool struct {
+	cfg      Config
+	mempool  *sdkmempool.PriorityNonceMempool
+	txs      map[txKey]int64
+	countTxs map[string]int
+}
+
+// txKey identifies a tx in the mempool.
+type txKey struct {
+	sender string
+	nonce  uint64
+}
+
+func NewMempool(cfg Config) *Mempool {
+	return &Mempool{
+		cfg:      cfg,
+		mempool:  sdkmempool.NewPriorityMempool(),
+		txs:      make(map[txKey]int64),
+		countTxs: make(map[string]int),
+	}
+}
+
+// Insert inserts the tx with the priority of the context. A tx replacing a
+// pending tx of the same sender and nonce does not count towards the limits.
+func (mp *Mempool) Insert(ctx context.Context, tx sdk.Tx) error {
+	wrapped, key, err := wrapTx(tx)
+	if err != nil {
+		return err
+	}
+	priority := sdk.UnwrapSDKContext(ctx).Priority()
+
+	if _, found := mp.txs[key]; !found {
+		if mp.cfg.MaxTxsPerSender > 0 && mp.countTxs[key.sender] >= mp.cfg.MaxTxsPerSender {
+			return errorsmod.Wrapf(gaiaerrors.ErrMempoolSenderLimit, "%s has %d pending txs", key.sender, mp.countTxs[key.sender])
+		}
+		if mp.cfg.MaxTxs > 0 && mp.CountTx() >= mp.cfg.MaxTxs {
+			if err := mp.evict(ctx, key, priority); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err := mp.mempool.Insert(ctx, wrapped); err != nil {
+		return err
+	}
+	if _, found := mp.txs[key]; !found {
+		mp.countTxs[key.sender]++
+	}
+	mp.txs[key] = priority
+
+	return nil
+}
+
+// evict removes the last tx in the order of the mempool, i.e. the tx with the
+// lowest priority and the highest nonce of its sender, if the inserted tx has
+// a higher priority. It takes O(n) time, only when the mempool is full. The
+// evicted tx stays in the CometBFT mempool, see Config.MaxTxs.
+func (mp *Mempool) evict(ctx context.Context, inserted txKey, priority int64) error {
+	var last sdk.Tx
+	for it := mp.mempool.Select(ctx, nil); it != nil; it = it.Next() {
+		last = it.Tx()
+	}
+	if last == nil {
+		return errorsmod.Wrap(gaiaerrors.ErrMempoolFull, "no tx to evict")
+	}
+
+	key := last.(senderTx).key
+	// the previous txs of the sender are kept, as the inserted tx could not be
+	// included without them
+	if lowest := mp.txs[key]; priority <= lowest || key.sender == inserted.sender {
+		return errorsmod.Wrapf(gaiaerrors.ErrMempoolFull, "tx priority %d must be higher than the lowest priority %d in the mempool", priority, lowest)
+	}
+
+	return mp.remove(last.(senderTx))
+}
+
+// Select returns an iterator over the txs ordered by priority and nonce.
+func (mp *Mempool) Select(ctx context.Context, txs [][]byte) sdkmempool.Iterator {
+	return newIterator(mp.mempool.Select(ctx, txs))
+}
+
+// CountTx returns the number of txs in the mempool.
+func (mp *Mempool) CountTx() int {
+	return mp.mempool.CountTx()
+}
+
+// Remove removes the tx from the mempool. It returns ErrTxNotFound for a tx
+// without signer, which cannot be inserted: baseapp fails the txs in
+// DeliverTx on any other error.
+func (mp *Mempool) Remove(tx sdk.Tx) error {
+	wrapped, _, err := wrapTx(tx)
+	if err != nil {
+		return sdkmempool.ErrTxNotFound
+	}
+
+	return mp.remove(wrapped)
+}
+
+func (mp *Mempool) remove(tx senderTx) error {
+	if err := mp.mempool.Remove(tx); err != nil {
+		return err
+	}
+
+	key := tx.key
+	delete(mp.txs, key)
+	if mp.countTxs[key.sender]--; mp.countTxs[key.sender] <= 0 {
+		delete(mp.countTxs, key.sender)
+	}
+
+	return nil
+}
+
+// senderTx is a tx wrapped for the PriorityNonceMempool, with the public key
+// of its first signature replaced by the address of its first signer.
+type senderTx struct {
+	signing.SigVerifiableTx
+	key  txKey
+	sigs []signingtypes.SignatureV2
+}
+
+func (tx senderTx) GetSignaturesV2() ([]signingtypes.SignatureV2, error) {
+	return tx.sigs, nil
+}
+
+// signerPubKey is the address of a signer in place of its public key, only
+// its Address method can be called.
+type signerPubKey struct {
+	cryptotypes.PubKey
+	address sdk.AccAddress
+}
+
+func (pk signerPubKey) Address() crypto.Address {
+	return crypto.Address(pk.address)
+}
+
+// wrapTx returns the tx wrapped for the PriorityNonceMempool, and its sender
+// and nonce: the first signer and the sequence of the first signature.
+func wrapTx(tx sdk.Tx) (senderTx, txKey, error) {
+	sigTx, ok := tx.(signing.SigVerifiableTx)
+	if !ok {
+		return senderTx{}, txKey{}, fmt.Errorf("tx of type %T does not implement SigVerifiableTx", tx)
+	}
+	signers := sigTx.GetSigners()
+	if len(signers) == 0 {
+		return senderTx{}, txKey{}, errors.New("tx must have at least one signer")
+	}
+	sigs, err := sigTx.GetSignaturesV2()
+	if err != nil {
+		return senderTx{}, txKey{}, err
+	}
+	if len(sigs) == 0 {
+		return senderTx{}, txKey{}, errors.New("tx must have at least one signature")
+	}
+
+	key := txKey{
+		sender: signers[0].String(),
+		nonce:  sigs[0].Sequence,
+	}
+	sigs = append([]signingtypes.SignatureV2{}, sigs...)
+	sigs[0].PubKey = signerPubKey{address: signers[0]}
+
+	return senderTx{SigVerifiableTx: sigTx, key: key, sigs: sigs}, key, nil
+}
+
+// iterator unwraps the txs of the PriorityNonceMempool.
+type iterator struct {
+	sdkmempool.Iterator
+}
+
+func newIterator(it sdkmempool.Iterator) sdkmempool.Iterator {
+	if it == nil {
+		return nil
+	}
+	return iterator{it}
+}
+
+func (it iterator) Next() sdkmempool.Iterator {
+	return newIterator(it.Iterator.Next())
+}
+
+func (it iterator) Tx() sdk.Tx {
+	return it.Iterator.Tx().(senderTx).SigVerifiableTx
+}
@@ -0,0 +1,137 @@
+package mempool_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cometbft/cometbft/libs/log"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkmempool "github.com/cosmos/cosmos-sdk/types/mempool"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/cosmos/gaia/v17/app/params"
+	"github.com/cosmos/gaia/v17/mempool"
+	gaiaerrors "github.com/cosmos/gaia/v17/types/errors"
+)
+
+type testTx struct {
+	tx       sdk.Tx
+	priority int64
+}
+
+func newTestTx(t *testing.T, txConfig client.TxConfig, sender cryptotypes.PrivKey, nonce uint64, priority int64) testTx {
+	t.Helper()
+
+	return newTestTxWithPubKey(t, txConfig, sender, sender.PubKey(), nonce, priority)
+}
+
+// newTestTxWithPubKey returns a tx with the given public key in its signature,
+// which is not set once the account has a public key on chain.
+func newTestTxWithPubKey(t *testing.T, txConfig client.TxConfig, sender cryptotypes.PrivKey, pubKey cryptotypes.PubKey, nonce uint64, priority int64) testTx {
+	t.Helper()
+
+	txBuilder := txConfig.NewTxBuilder()
+	require.NoError(t, txBuilder.SetMsgs(&banktypes.MsgSend{FromAddress: sdk.AccAddress(sender.PubKey().Address()).String()}))
+	require.NoError(t, txBuilder.SetSignatures(signing.SignatureV2{
+		PubKey:   pubKey,
+		Data:     &signing.SingleSignatureData{SignMode: signing.SignMode_SIGN_MODE_DIRECT},
+		Sequence: nonce,
+	}))
+
+	return testTx{tx: txBuilder.GetTx(), priority: priority}
+}
+
+func TestMempool(t *testing.T) {
+	txConfig := params.MakeEncodingConfig().TxConfig
+	ctx := sdk.NewContext(nil, tmproto.Header{}, false, log.NewNopLogger())
+	alice, bob, carol := secp256k1.GenPrivKey(), secp256k1.GenPrivKey(), secp256k1.GenPrivKey()
+
+	insert := func(mp *mempool.Mempool, tx testTx) error {
+		return mp.Insert(ctx.WithPriority(tx.priority), tx.tx)
+	}
+	selectTxs := func(mp *mempool.Mempool) (txs []sdk.Tx) {
+		for it := mp.Select(ctx, nil); it != nil; it = it.Next() {
+			txs = append(txs, it.Tx())
+		}
+		return txs
+	}
+
+	t.Run("order by priority and nonce", func(t *testing.T) {
+		mp := mempool.NewMempool(mempool.Config{})
+		alice0 := newTestTx(t, txConfig, alice, 0, 10)
+		alice1 := newTestTx(t, txConfig, alice, 1, 30)
+		bob0 := newTestTx(t, txConfig, bob, 0, 20)
+		for _, tx := range []testTx{alice1, bob0, alice0} {
+			require.NoError(t, insert(mp, tx))
+		}
+
+		require.Equal(t, []sdk.Tx{bob0.tx, alice0.tx, alice1.tx}, selectTxs(mp))
+	})
+
+	t.Run("max txs per sender", func(t *testing.T) {
+		mp := mempool.NewMempool(mempool.Config{MaxTxsPerSender: 2})
+		require.NoError(t, insert(mp, newTestTx(t, txConfig, alice, 0, 10)))
+		require.NoError(t, insert(mp, newTestTx(t, txConfig, alice, 1, 10)))
+		require.ErrorIs(t, insert(mp, newTestTx(t, txConfig, alice, 2, 10)), gaiaerrors.ErrMempoolSenderLimit)
+		require.NoError(t, insert(mp, newTestTx(t, txConfig, bob, 0, 10)))
+
+		// a replacement does not count towards the limit
+		require.NoError(t, insert(mp, newTestTx(t, txConfig, alice, 1, 20)))
+		require.Equal(t, 3, mp.CountTx())
+
+		// the removed txs do not count towards the limit anymore
+		require.NoError(t, mp.Remove(newTestTx(t, txConfig, alice, 0, 10).tx))
+		require.NoError(t, insert(mp, newTestTx(t, txConfig, alice, 2, 10)))
+		require.Equal(t, 3, mp.CountTx())
+	})
+
+	t.Run("eviction", func(t *testing.T) {
+		mp := mempool.NewMempool(mempool.Config{MaxTxs: 2})
+		alice0 := newTestTx(t, txConfig, alice, 0, 10)
+		bob0 := newTestTx(t, txConfig, bob, 0, 20)
+		require.NoError(t, insert(mp, alice0))
+		require.NoError(t, insert(mp, bob0))
+
+		// a tx with a priority not higher than the lowest one is rejected
+		require.ErrorIs(t, insert(mp, newTestTx(t, txConfig, carol, 0, 10)), gaiaerrors.ErrMempoolFull)
+		// the lowest priority tx is not evicted for a later tx of its sender
+		require.ErrorIs(t, insert(mp, newTestTx(t, txConfig, alice, 1, 30)), gaiaerrors.ErrMempoolFull)
+		// a replacement is not limited
+		alice0 = newTestTx(t, txConfig, alice, 0, 15)
+		require.NoError(t, insert(mp, alice0))
+
+		// the lowest priority tx is evicted
+		carol0 := newTestTx(t, txConfig, carol, 0, 30)
+		require.NoError(t, insert(mp, carol0))
+		require.Equal(t, []sdk.Tx{carol0.tx, bob0.tx}, selectTxs(mp))
+		require.ErrorIs(t, mp.Remove(alice0.tx), sdkmempool.ErrTxNotFound)
+	})
+
+	t.Run("signature without public key", func(t *testing.T) {
+		mp := mempool.NewMempool(mempool.Config{MaxTxsPerSender: 2})
+		alice0 := newTestTxWithPubKey(t, txConfig, alice, nil, 0, 10)
+		alice1 := newTestTx(t, txConfig, alice, 1, 10)
+		require.NoError(t, insert(mp, alice0))
+		require.NoError(t, insert(mp, alice1))
+		require.Equal(t, []sdk.Tx{alice0.tx, alice1.tx}, selectTxs(mp))
+		// the sender is the signer, with or without public key
+		require.ErrorIs(t, insert(mp, newTestTxWithPubKey(t, txConfig, alice, nil, 2, 10)), gaiaerrors.ErrMempoolSenderLimit)
+
+		require.NoError(t, mp.Remove(newTestTxWithPubKey(t, txConfig, alice, nil, 1, 10).tx))
+		require.NoError(t, mp.Remove(alice0.tx))
+		require.Equal(t, 0, mp.CountTx())
+		require.ErrorIs(t, mp.Remove(alice0.tx), sdkmempool.ErrTxNotFound)
+
+		// a tx without signer cannot be removed, nor inserted
+		unsigned := txConfig.NewTxBuilder().GetTx()
+		require.ErrorIs(t, mp.Remove(unsigned), sdkmempool.ErrTxNotFound)
+		require.Error(t, insert(mp, testTx{tx: unsigned}))
+	})
+}
@@ -3,7 +3,14 @@ package address
 import (
 	"fmt"
 
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	icatypes "github.com/cosmos/ibc-go/v7/modules/apps/27-interchain-accounts/types"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/bech32"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
 // ConvertBech32Prefix convert bech32 address to specified prefix.
@@ -20,3 +27,52 @@ func ConvertBech32Prefix(address, prefix string) (string, error) {
 
 	return convertedAddress, nil
 }
+
+// ModuleAddress returns the address of the module account with the given
+// name, encoded with the prefix.
+func ModuleAddress(name, prefix string) (string, error) {
+	if name == "" {
+		return "", fmt.Errorf("module name cannot be empty")
+	}
+
+	return encode(prefix, authtypes.NewModuleAddress(name))
+}
+
+// ICAHostAddress returns the address of the interchain account registered on
+// the host chain by the owner of the controller chain over the connection,
+// encoded with the prefix.
+//
+// Since ibc-go v7, the address also depends on the app hash and the data hash
+// of the header of the host chain block in which the channel was opened
+// (MsgChannelOpenTry), which are given as is.
+func ICAHostAddress(connectionID, owner string, appHash, dataHash []byte, prefix string) (string, error) {
+	if connectionID == "" {
+		return "", fmt.Errorf("connection ID cannot be empty")
+	}
+	portID, err := icatypes.NewControllerPortID(owner)
+	if err != nil {
+		return "", err
+	}
+
+	ctx := sdk.Context{}.WithBlockHeader(tmproto.Header{AppHash: appHash, DataHash: dataHash})
+	return encode(prefix, icatypes.GenerateAddress(ctx, connectionID, portID))
+}
+
+// EscrowAddress returns the address of the ICS-20 escrow account of the port
+// and channel, encoded with the prefix.
+func EscrowAddress(portID, channelID, prefix string) (string, error) {
+	if portID == "" || channelID == "" {
+		return "", fmt.Errorf("port ID and channel ID cannot be empty")
+	}
+
+	return encode(prefix, transfertypes.GetEscrowAddress(portID, channelID))
+}
+
+func encode(prefix string, bz []byte) (string, error) {
+	address, err := bech32.ConvertAndEncode(prefix, bz)
+	if err != nil {
+		return "", fmt.Errorf("cannot encode address with prefix %q: %s", prefix, err)
+	}
+
+	return address, nil
+}
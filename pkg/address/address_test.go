@@ -41,3 +41,27 @@ func TestConvertBech32Prefix(t *testing.T) {
 		})
 	}
 }
+
+func TestDerivedAddresses(t *testing.T) {
+	address, err := ModuleAddress("bonded_tokens_pool", "cosmos")
+	require.NoError(t, err)
+	require.Equal(t, "cosmos1fl48vsnmsdzcv85q5d2q4z5ajdha8yu34mf0eh", address)
+	_, err = ModuleAddress("", "cosmos")
+	require.ErrorContains(t, err, "module name cannot be empty")
+
+	address, err = EscrowAddress("transfer", "channel-0", "osmo")
+	require.NoError(t, err)
+	require.Equal(t, "osmo1a53udazy8ayufvy0s434pfwjcedzqv347h34au", address)
+	_, err = EscrowAddress("transfer", "", "cosmos")
+	require.ErrorContains(t, err, "port ID and channel ID cannot be empty")
+
+	// the interchain account address depends on the block header
+	address, err = ICAHostAddress("connection-0", "cosmos1owner", []byte{1}, []byte{2}, "cosmos")
+	require.NoError(t, err)
+	require.Equal(t, "cosmos15tmqd8qewwfnsqu887gedspafzwv30ppzelcft6mh3nth7jqznsq4fq4mn", address)
+	other, err := ICAHostAddress("connection-0", "cosmos1owner", nil, nil, "cosmos")
+	require.NoError(t, err)
+	require.NotEqual(t, address, other)
+	_, err = ICAHostAddress("connection-0", "", nil, nil, "cosmos")
+	require.ErrorContains(t, err, "owner address cannot be empty")
+}
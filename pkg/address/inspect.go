@@ -0,0 +1,211 @@
+package address
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/btcutil/bech32"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkbech32 "github.com/cosmos/cosmos-sdk/types/bech32"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// Format is the encoding of an address.
+type Format string
+
+const (
+	FormatBech32  Format = "bech32"
+	FormatBech32m Format = "bech32m"
+	FormatHex     Format = "hex"
+	FormatBase64  Format = "base64"
+)
+
+// Kind is the kind of account an address belongs to, told by its length.
+type Kind string
+
+const (
+	// KindAccount is a 20-byte address, of a public key or of a module account.
+	KindAccount Kind = "account"
+	// KindDerived is a 32-byte address derived from a module, e.g. of an
+	// interchain account.
+	KindDerived Kind = "derived"
+	KindUnknown Kind = "unknown"
+)
+
+// bech32 and bech32m checksum constants, see BIP-173 and BIP-350.
+const (
+	bech32Const  = 1
+	bech32mConst = 0x2bc830a3
+)
+
+// Info describes an address.
+type Info struct {
+	Input  string `json:"input"`
+	Format Format `json:"format"`
+	// HRP is the human-readable part of a bech32 address.
+	HRP string `json:"hrp,omitempty"`
+	// ValidChecksum tells whether the checksum of a bech32 address is valid,
+	// it is always true for the other formats.
+	ValidChecksum bool   `json:"valid_checksum"`
+	Hex           string `json:"hex"`
+	Length        int    `json:"length"`
+	Kind          Kind   `json:"kind"`
+	// Valid tells whether the address can be used on chain: its checksum is a
+	// valid bech32 one and its length is accepted by the SDK.
+	Valid bool `json:"valid"`
+	// Module is the name of the known module account of the address.
+	Module    string `json:"module,omitempty"`
+	Account   string `json:"account"`
+	Validator string `json:"validator"`
+	Consensus string `json:"consensus"`
+}
+
+// Inspect detects the format of the address, given in bech32, bech32m, hex or
+// base64, and describes it. The address is matched against the module
+// accounts of the given names and encoded with the prefixes of the SDK config.
+func Inspect(input string, moduleNames []string) (Info, error) {
+	input = strings.TrimSpace(input)
+	if input == "" {
+		return Info{}, fmt.Errorf("address cannot be empty")
+	}
+
+	info := Info{Input: input}
+	bz, err := decode(input, &info)
+	if err != nil {
+		return Info{}, err
+	}
+
+	info.Hex = strings.ToUpper(hex.EncodeToString(bz))
+	info.Length = len(bz)
+	switch len(bz) {
+	case 20:
+		info.Kind = KindAccount
+	case 32:
+		info.Kind = KindDerived
+	default:
+		info.Kind = KindUnknown
+	}
+	info.Valid = info.ValidChecksum && info.Format != FormatBech32m && sdk.VerifyAddressFormat(bz) == nil
+	for _, name := range moduleNames {
+		if authtypes.NewModuleAddress(name).Equals(sdk.AccAddress(bz)) {
+			info.Module = name
+			break
+		}
+	}
+
+	config := sdk.GetConfig()
+	if info.Account, err = sdkbech32.ConvertAndEncode(config.GetBech32AccountAddrPrefix(), bz); err != nil {
+		return Info{}, err
+	}
+	if info.Validator, err = sdkbech32.ConvertAndEncode(config.GetBech32ValidatorAddrPrefix(), bz); err != nil {
+		return Info{}, err
+	}
+	if info.Consensus, err = sdkbech32.ConvertAndEncode(config.GetBech32ConsensusAddrPrefix(), bz); err != nil {
+		return Info{}, err
+	}
+
+	return info, nil
+}
+
+// decode decodes the address and sets its format. A string shaped like a
+// bech32 address, i.e. with a lowercase letters prefix, a separator and data
+// of the bech32 charset, is decoded as bech32 even if its checksum is
+// invalid, as a mistyped bech32 address may also be valid base64. Hex and
+// base64 are tried otherwise.
+func decode(input string, info *Info) ([]byte, error) {
+	hrp, bz, version, err := decodeBech32(input)
+	if err == nil && isBech32HRP(hrp) {
+		info.Format, info.HRP, info.ValidChecksum = FormatBech32, hrp, false
+		if version != "" {
+			info.Format, info.ValidChecksum = version, true
+		}
+		return bz, nil
+	}
+
+	if bz, err := hex.DecodeString(strings.TrimPrefix(strings.TrimPrefix(input, "0x"), "0X")); err == nil {
+		info.Format, info.ValidChecksum = FormatHex, true
+		return bz, nil
+	}
+	if bz, err := base64.StdEncoding.DecodeString(input); err == nil {
+		info.Format, info.ValidChecksum = FormatBase64, true
+		return bz, nil
+	}
+
+	return nil, fmt.Errorf("cannot decode %s address: not a bech32, hex or base64 address", input)
+}
+
+// isBech32HRP tells whether the human-readable part is made of letters only,
+// as the prefixes of the addresses are, so that a hex address holding a 1 is
+// not taken for a bech32 one.
+func isBech32HRP(hrp string) bool {
+	if hrp == "" {
+		return false
+	}
+	for _, c := range hrp {
+		if c < 'a' || c > 'z' {
+			return false
+		}
+	}
+
+	return true
+}
+
+// decodeBech32 decodes a bech32 or bech32m address. The format is empty if
+// the checksum is invalid.
+func decodeBech32(input string) (string, []byte, Format, error) {
+	lower := strings.ToLower(input)
+	if lower != input && strings.ToUpper(input) != input {
+		return "", nil, "", fmt.Errorf("mixed case")
+	}
+
+	hrp, data, checksum, err := bech32.DecodeUnsafe(lower)
+	if err != nil {
+		return "", nil, "", err
+	}
+	bz, err := bech32.ConvertBits(data, 5, 8, false)
+	if err != nil {
+		return "", nil, "", err
+	}
+
+	var format Format
+	switch bech32Polymod(hrp, append(append([]byte{}, data...), checksum...)) {
+	case bech32Const:
+		format = FormatBech32
+	case bech32mConst:
+		format = FormatBech32m
+	}
+
+	return hrp, bz, format, nil
+}
+
+// bech32Polymod computes the BIP-173 checksum polynomial of the human-readable
+// part and of the 5-bit values.
+func bech32Polymod(hrp string, values []byte) int {
+	generator := [5]int{0x3b6a57b2, 0x26508e6d, 0x1ea119fa, 0x3d4233dd, 0x2a1462b3}
+	chk := 1
+	step := func(v byte) {
+		top := chk >> 25
+		chk = (chk&0x1ffffff)<<5 ^ int(v)
+		for i := 0; i < 5; i++ {
+			if (top>>uint(i))&1 == 1 {
+				chk ^= generator[i]
+			}
+		}
+	}
+
+	for i := 0; i < len(hrp); i++ {
+		step(hrp[i] >> 5)
+	}
+	step(0)
+	for i := 0; i < len(hrp); i++ {
+		step(hrp[i] & 31)
+	}
+	for _, v := range values {
+		step(v)
+	}
+
+	return chk
+}
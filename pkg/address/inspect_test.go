@@ -0,0 +1,124 @@
+package address
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestInspect(t *testing.T) {
+	const bondedPoolHex = "4FEA76427B8345861E80A3540A8A9D936FD39391"
+	moduleNames := []string{"bonded_tokens_pool", "transfer"}
+
+	cases := []struct {
+		name          string
+		input         string
+		format        Format
+		hrp           string
+		validChecksum bool
+		hex           string
+		kind          Kind
+		valid         bool
+		module        string
+		err           string
+	}{
+		{
+			name:          "bech32 module account",
+			input:         "cosmos1fl48vsnmsdzcv85q5d2q4z5ajdha8yu34mf0eh",
+			format:        FormatBech32,
+			hrp:           "cosmos",
+			validChecksum: true,
+			hex:           bondedPoolHex,
+			kind:          KindAccount,
+			valid:         true,
+			module:        "bonded_tokens_pool",
+		},
+		{
+			name:   "bech32 with an invalid checksum",
+			input:  "cosmos1fl48vsnmsdzcv85q5d2q4z5ajdha8yu34mf0ex",
+			format: FormatBech32,
+			hrp:    "cosmos",
+			hex:    bondedPoolHex,
+			kind:   KindAccount,
+			module: "bonded_tokens_pool",
+		},
+		{
+			// a mistyped bech32 address is also valid base64
+			name:   "mistyped bech32 of base64 characters",
+			input:  "akash1a6zlyvpnksx8wr6wz8wemur2xe8zyh0ytz6d8x",
+			format: FormatBech32,
+			hrp:    "akash",
+			hex:    "EE85F23033B40C770F4E11DD9DF06A364E225DE4",
+			kind:   KindAccount,
+		},
+		{
+			name:          "bech32m",
+			input:         "abcdef1l7aum6echk45nj3s0wdvt2fg8x9yrzpqzd3ryx",
+			format:        FormatBech32m,
+			hrp:           "abcdef",
+			validChecksum: true,
+			hex:           "FFBBCDEB38BDAB49CA307B9AC5A928398A418820",
+			kind:          KindAccount,
+		},
+		{
+			name:          "interchain account",
+			input:         "cosmos1dg6hk8xw5m99un4ldj6wp59h5spmjaqqx39au7cjsn9vmnq2vw7szdywt3",
+			format:        FormatBech32,
+			hrp:           "cosmos",
+			validChecksum: true,
+			hex:           "6A357B1CCEA6CA5E4EBF6CB4E0D0B7A403B97400344BDE7B1284CACDCC0A63BD",
+			kind:          KindDerived,
+			valid:         true,
+		},
+		{
+			name:          "hex",
+			input:         "0x" + bondedPoolHex,
+			format:        FormatHex,
+			validChecksum: true,
+			hex:           bondedPoolHex,
+			kind:          KindAccount,
+			valid:         true,
+			module:        "bonded_tokens_pool",
+		},
+		{
+			name:          "base64",
+			input:         "T+p2QnuDRYYegKNUCoqdk2/Tk5E=",
+			format:        FormatBase64,
+			validChecksum: true,
+			hex:           bondedPoolHex,
+			kind:          KindAccount,
+			valid:         true,
+			module:        "bonded_tokens_pool",
+		},
+		{
+			name:  "invalid address",
+			input: "not an address",
+			err:   "not a bech32, hex or base64 address",
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			info, err := Inspect(tt.input, moduleNames)
+			if tt.err != "" {
+				require.ErrorContains(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.format, info.Format)
+			require.Equal(t, tt.hrp, info.HRP)
+			require.Equal(t, tt.validChecksum, info.ValidChecksum)
+			require.Equal(t, tt.hex, info.Hex)
+			require.Equal(t, len(tt.hex)/2, info.Length)
+			require.Equal(t, tt.kind, info.Kind)
+			require.Equal(t, tt.valid, info.Valid)
+			require.Equal(t, tt.module, info.Module)
+		})
+	}
+
+	info, err := Inspect(bondedPoolHex, nil)
+	require.NoError(t, err)
+	require.Equal(t, "cosmos1fl48vsnmsdzcv85q5d2q4z5ajdha8yu34mf0eh", info.Account)
+	require.Equal(t, "cosmosvaloper1fl48vsnmsdzcv85q5d2q4z5ajdha8yu3s0a64y", info.Validator)
+	require.Equal(t, "cosmosvalcons1fl48vsnmsdzcv85q5d2q4z5ajdha8yu3yuwxe9", info.Consensus)
+}
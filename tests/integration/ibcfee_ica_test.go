@@ -0,0 +1,204 @@
+package integration
+
+import (
+	"github.com/cosmos/gogoproto/proto"
+
+	icahosttypes "github.com/cosmos/ibc-go/v7/modules/apps/27-interchain-accounts/host/types"
+	icatypes "github.com/cosmos/ibc-go/v7/modules/apps/27-interchain-accounts/types"
+	ibcfeetypes "github.com/cosmos/ibc-go/v7/modules/apps/29-fee/types"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v7/testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+var (
+	// ICA + IBC fee test variables
+	defaultICAOwnerAddress = "cosmos17dtl0mjt3t77kpuhg2edqzjpszulwhgzuj9ljs"
+	defaultICAVersion      = icatypes.NewDefaultMetadataString(ibctesting.FirstConnectionID, ibctesting.FirstConnectionID)
+)
+
+// Integration test to ensure ics29 works with ics27
+// Source: https://github.com/cosmos/ibc-go/blob/v7.3.2/modules/apps/29-fee/ica_test.go#L94
+func (suite *IBCFeeTestSuite) TestFeeInterchainAccounts() {
+	feeICAVersion := string(ibcfeetypes.ModuleCdc.MustMarshalJSON(&ibcfeetypes.Metadata{FeeVersion: ibcfeetypes.Version, AppVersion: defaultICAVersion}))
+	path := newICAPath(suite.chainA, suite.chainB, feeICAVersion)
+	suite.coordinator.SetupConnections(path)
+
+	err := suite.setupICAPath(path, defaultICAOwnerAddress)
+	suite.Require().NoError(err)
+
+	// assert the newly established channel is fee enabled on both ends
+	suite.Require().True(getApp(suite.chainA).IBCFeeKeeper.IsFeeEnabled(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID))
+	suite.Require().True(getApp(suite.chainB).IBCFeeKeeper.IsFeeEnabled(suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID))
+
+	// register counterparty address on destination chainB as chainA.SenderAccounts[1] for recv fee distribution
+	getApp(suite.chainB).IBCFeeKeeper.SetCounterpartyPayeeAddress(suite.chainB.GetContext(), suite.chainB.SenderAccount.GetAddress().String(), suite.chainA.SenderAccounts[1].SenderAccount.GetAddress().String(), path.EndpointB.ChannelID)
+
+	// escrow a packet fee for the next send sequence
+	expectedFee := ibcfeetypes.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
+	msgPayPacketFee := ibcfeetypes.NewMsgPayPacketFee(expectedFee, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, suite.chainA.SenderAccount.GetAddress().String(), nil)
+
+	// fetch the account balance before fees are escrowed and assert the difference below
+	preEscrowBalance := getApp(suite.chainA).BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), sdk.DefaultBondDenom)
+
+	res, err := suite.chainA.SendMsgs(msgPayPacketFee)
+	suite.Require().NotNil(res)
+	suite.Require().NoError(err)
+
+	postEscrowBalance := getApp(suite.chainA).BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), sdk.DefaultBondDenom)
+	suite.Require().Equal(postEscrowBalance.AddAmount(expectedFee.Total().AmountOf(sdk.DefaultBondDenom)), preEscrowBalance)
+
+	packetID := channeltypes.NewPacketID(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, 1)
+	packetFees, found := getApp(suite.chainA).IBCFeeKeeper.GetFeesInEscrow(suite.chainA.GetContext(), packetID)
+	suite.Require().True(found)
+	suite.Require().Equal(expectedFee, packetFees.PacketFees[0].Fee)
+
+	packet := suite.buildDelegateICAPacket(path)
+
+	err = path.RelayPacket(packet)
+	suite.Require().NoError(err)
+
+	// ensure escrowed fees are cleaned up
+	packetFees, found = getApp(suite.chainA).IBCFeeKeeper.GetFeesInEscrow(suite.chainA.GetContext(), packetID)
+	suite.Require().False(found)
+	suite.Require().Empty(packetFees)
+
+	// assert the value of the account balance after fee distribution
+	// NOTE: the balance after fee distribution should be equal to the pre-escrow balance minus the recv fee
+	// as chainA.SenderAccount is used as the msg signer and refund address for msgPayPacketFee above as well as the relayer account for acknowledgements in path.RelayPacket()
+	postDistBalance := getApp(suite.chainA).BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), sdk.DefaultBondDenom)
+	suite.Require().Equal(preEscrowBalance.SubAmount(defaultRecvFee.AmountOf(sdk.DefaultBondDenom)), postDistBalance)
+}
+
+// Integration test to ensure ICA channels negotiated without the fee version
+// (e.g. channels opened before the fee middleware was added to the ICA stacks)
+// keep working as plain ics27 channels.
+func (suite *IBCFeeTestSuite) TestNonIncentivizedInterchainAccounts() {
+	path := newICAPath(suite.chainA, suite.chainB, defaultICAVersion)
+	suite.coordinator.SetupConnections(path)
+
+	err := suite.setupICAPath(path, defaultICAOwnerAddress)
+	suite.Require().NoError(err)
+
+	// assert the channel is not fee enabled on either end
+	suite.Require().False(getApp(suite.chainA).IBCFeeKeeper.IsFeeEnabled(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID))
+	suite.Require().False(getApp(suite.chainB).IBCFeeKeeper.IsFeeEnabled(suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID))
+
+	packet := suite.buildDelegateICAPacket(path)
+
+	err = path.RelayPacket(packet)
+	suite.Require().NoError(err)
+
+	// the interchain account executed the delegation on chainB
+	interchainAccountAddr, found := getApp(suite.chainB).ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID)
+	suite.Require().True(found)
+	delegations := getApp(suite.chainB).StakingKeeper.GetAllDelegatorDelegations(suite.chainB.GetContext(), sdk.MustAccAddressFromBech32(interchainAccountAddr))
+	suite.Require().Len(delegations, 1)
+}
+
+// newICAPath creates and returns a new ibctesting path configured for an
+// interchain accounts channel between the controller on chainA and the host on chainB
+func newICAPath(chainA, chainB *ibctesting.TestChain, version string) *ibctesting.Path {
+	path := ibctesting.NewPath(chainA, chainB)
+
+	controllerPortID, err := icatypes.NewControllerPortID(defaultICAOwnerAddress)
+	if err != nil {
+		panic(err)
+	}
+
+	path.SetChannelOrdered()
+	path.EndpointA.ChannelConfig.Version = version
+	path.EndpointB.ChannelConfig.Version = version
+	path.EndpointA.ChannelConfig.PortID = controllerPortID
+	path.EndpointB.ChannelConfig.PortID = icatypes.HostPortID
+
+	return path
+}
+
+// setupICAPath registers an interchain account for owner on the controller chain
+// and completes the channel handshake on the given path
+func (suite *IBCFeeTestSuite) setupICAPath(path *ibctesting.Path, owner string) error {
+	endpoint := path.EndpointA
+	channelSequence := endpoint.Chain.App.GetIBCKeeper().ChannelKeeper.GetNextChannelSequence(endpoint.Chain.GetContext())
+
+	if err := getApp(endpoint.Chain).ICAControllerKeeper.RegisterInterchainAccount(endpoint.Chain.GetContext(), endpoint.ConnectionID, owner, endpoint.ChannelConfig.Version); err != nil {
+		return err
+	}
+
+	// commit state changes for proof verification
+	endpoint.Chain.NextBlock()
+
+	// update channel id
+	endpoint.ChannelID = channeltypes.FormatChannelIdentifier(channelSequence)
+
+	if err := path.EndpointB.ChanOpenTry(); err != nil {
+		return err
+	}
+
+	if err := path.EndpointA.ChanOpenAck(); err != nil {
+		return err
+	}
+
+	return path.EndpointB.ChanOpenConfirm()
+}
+
+// buildDelegateICAPacket funds the interchain account on chainB, allows it to
+// delegate, and commits an ICA packet with a MsgDelegate on chainA
+func (suite *IBCFeeTestSuite) buildDelegateICAPacket(path *ibctesting.Path) channeltypes.Packet {
+	interchainAccountAddr, found := getApp(suite.chainB).ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID)
+	suite.Require().True(found)
+
+	// fund the interchain account on chainB
+	coins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100000)))
+	msgBankSend := &banktypes.MsgSend{
+		FromAddress: suite.chainB.SenderAccount.GetAddress().String(),
+		ToAddress:   interchainAccountAddr,
+		Amount:      coins,
+	}
+
+	res, err := suite.chainB.SendMsgs(msgBankSend)
+	suite.Require().NotEmpty(res)
+	suite.Require().NoError(err)
+
+	// prepare a simple stakingtypes.MsgDelegate to be used as the interchain account msg executed on chainB
+	validatorAddr := (sdk.ValAddress)(suite.chainB.Vals.Validators[0].Address)
+	msgDelegate := &stakingtypes.MsgDelegate{
+		DelegatorAddress: interchainAccountAddr,
+		ValidatorAddress: validatorAddr.String(),
+		Amount:           sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(5000)),
+	}
+
+	data, err := icatypes.SerializeCosmosTx(getApp(suite.chainA).AppCodec(), []proto.Message{msgDelegate})
+	suite.Require().NoError(err)
+
+	icaPacketData := icatypes.InterchainAccountPacketData{
+		Type: icatypes.EXECUTE_TX,
+		Data: data,
+	}
+
+	// ensure chainB is allowed to execute stakingtypes.MsgDelegate
+	params := icahosttypes.NewParams(true, []string{sdk.MsgTypeURL(msgDelegate)})
+	getApp(suite.chainB).ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+
+	packet := channeltypes.NewPacket(
+		icaPacketData.GetBytes(),
+		1,
+		path.EndpointA.ChannelConfig.PortID,
+		path.EndpointA.ChannelID,
+		path.EndpointB.ChannelConfig.PortID,
+		path.EndpointB.ChannelID,
+		clienttypes.NewHeight(1, 100),
+		0,
+	)
+
+	// write packet commitment to state on chainA and commit state
+	commitment := channeltypes.CommitPacket(getApp(suite.chainA).AppCodec(), packet)
+	getApp(suite.chainA).IBCKeeper.ChannelKeeper.SetPacketCommitment(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, 1, commitment)
+	suite.chainA.NextBlock()
+
+	return packet
+}
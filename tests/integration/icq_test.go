@@ -0,0 +1,267 @@
+package integration
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v7/testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/cosmos/gaia/v17/x/icq"
+	icqtypes "github.com/cosmos/gaia/v17/x/icq/types"
+)
+
+// icqControllerPortID is the port used by chainA to query the ICQ host on chainB.
+// Gaia does not run an ICQ controller, so the controller channel end is written
+// directly to the chainA store.
+const icqControllerPortID = "icqcontroller"
+
+type ICQTestSuite struct {
+	suite.Suite
+	coordinator *ibctesting.Coordinator
+
+	// chainA acts as the querying (controller) chain, chainB as the Hub hosting the queries
+	chainA *ibctesting.TestChain
+	chainB *ibctesting.TestChain
+
+	path *ibctesting.Path
+}
+
+func TestICQTestSuite(t *testing.T) {
+	suite.Run(t, new(ICQTestSuite))
+}
+
+func (suite *ICQTestSuite) SetupTest() {
+	ibctesting.DefaultTestingAppInit = GaiaAppIniter
+	suite.coordinator = ibctesting.NewCoordinator(suite.T(), 2)
+	suite.chainA = suite.coordinator.GetChain(ibctesting.GetChainID(1))
+	suite.chainB = suite.coordinator.GetChain(ibctesting.GetChainID(2))
+
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	path.EndpointA.ChannelConfig.PortID = icqControllerPortID
+	path.EndpointA.ChannelConfig.Version = icqtypes.Version
+	path.EndpointA.ChannelConfig.Order = channeltypes.UNORDERED
+	path.EndpointB.ChannelConfig.PortID = icqtypes.PortID
+	path.EndpointB.ChannelConfig.Version = icqtypes.Version
+	path.EndpointB.ChannelConfig.Order = channeltypes.UNORDERED
+	suite.path = path
+
+	suite.coordinator.SetupConnections(path)
+	suite.openICQChannel()
+}
+
+// openICQChannel performs the channel handshake with the ICQ host on chainB.
+// The chainA channel end is written directly to its store, the chainB end
+// goes through the ICQ host module callbacks.
+func (suite *ICQTestSuite) openICQChannel() {
+	path := suite.path
+
+	path.EndpointA.ChannelID = channeltypes.FormatChannelIdentifier(0)
+	path.EndpointA.SetChannel(channeltypes.NewChannel(
+		channeltypes.INIT, channeltypes.UNORDERED,
+		channeltypes.NewCounterparty(icqtypes.PortID, ""),
+		[]string{path.EndpointA.ConnectionID}, icqtypes.Version,
+	))
+	suite.coordinator.CommitBlock(suite.chainA)
+
+	suite.Require().NoError(path.EndpointB.ChanOpenTry())
+
+	path.EndpointA.SetChannel(channeltypes.NewChannel(
+		channeltypes.OPEN, channeltypes.UNORDERED,
+		channeltypes.NewCounterparty(icqtypes.PortID, path.EndpointB.ChannelID),
+		[]string{path.EndpointA.ConnectionID}, icqtypes.Version,
+	))
+	suite.coordinator.CommitBlock(suite.chainA)
+
+	suite.Require().NoError(path.EndpointB.ChanOpenConfirm())
+}
+
+func (suite *ICQTestSuite) TestChannelHandshake() {
+	channel := suite.path.EndpointB.GetChannel()
+	suite.Require().Equal(channeltypes.OPEN, channel.State)
+	suite.Require().Equal(icqtypes.Version, channel.Version)
+
+	module := icq.NewIBCModule(getApp(suite.chainB).ICQKeeper)
+	ctx := suite.chainB.GetContext()
+	counterparty := channeltypes.NewCounterparty(icqControllerPortID, suite.path.EndpointA.ChannelID)
+	connHops := []string{suite.path.EndpointB.ConnectionID}
+
+	// the handshake must be initiated by the controller
+	_, err := module.OnChanOpenInit(ctx, channeltypes.UNORDERED, connHops, icqtypes.PortID, "channel-1", nil, counterparty, icqtypes.Version)
+	suite.Require().ErrorIs(err, icqtypes.ErrInvalidChannelFlow)
+
+	// only unordered channels are supported
+	_, err = module.OnChanOpenTry(ctx, channeltypes.ORDERED, connHops, icqtypes.PortID, "channel-1", nil, counterparty, icqtypes.Version)
+	suite.Require().ErrorIs(err, channeltypes.ErrInvalidChannelOrdering)
+
+	_, err = module.OnChanOpenTry(ctx, channeltypes.UNORDERED, connHops, icqtypes.PortID, "channel-1", nil, counterparty, "ics20-1")
+	suite.Require().ErrorIs(err, icqtypes.ErrInvalidVersion)
+
+	// channels can not be closed by users
+	suite.Require().ErrorIs(module.OnChanCloseInit(ctx, icqtypes.PortID, suite.path.EndpointB.ChannelID), icqtypes.ErrInvalidChannelFlow)
+}
+
+func (suite *ICQTestSuite) TestQueries() {
+	testCases := []struct {
+		name     string
+		malleate func()
+		reqs     func() []abci.RequestQuery
+		expErr   error
+	}{
+		{
+			"success: bank balance and staking validator",
+			func() {},
+			func() []abci.RequestQuery {
+				return []abci.RequestQuery{suite.balanceQuery(), suite.validatorQuery()}
+			},
+			nil,
+		},
+		{
+			"failure: query path not allowed",
+			func() {},
+			func() []abci.RequestQuery {
+				return []abci.RequestQuery{suite.balanceQuery(), {
+					Path: "/cosmos.auth.v1beta1.Query/Accounts",
+					Data: getApp(suite.chainB).AppCodec().MustMarshal(&authtypes.QueryAccountsRequest{}),
+				}}
+			},
+			icqtypes.ErrQueryNotAllowed,
+		},
+		{
+			"failure: query height not supported",
+			func() {},
+			func() []abci.RequestQuery {
+				req := suite.balanceQuery()
+				req.Height = 1
+				return []abci.RequestQuery{req}
+			},
+			icqtypes.ErrInvalidQuery,
+		},
+		{
+			"failure: query gas limit exceeded",
+			func() {
+				params := icqtypes.DefaultParams()
+				params.MaxQueryGas = 1
+				getApp(suite.chainB).ICQKeeper.SetParams(suite.chainB.GetContext(), params)
+			},
+			func() []abci.RequestQuery {
+				return []abci.RequestQuery{suite.balanceQuery()}
+			},
+			icqtypes.ErrQueryGasLimitExceeded,
+		},
+		{
+			"failure: host disabled",
+			func() {
+				params := icqtypes.DefaultParams()
+				params.HostEnabled = false
+				getApp(suite.chainB).ICQKeeper.SetParams(suite.chainB.GetContext(), params)
+			},
+			func() []abci.RequestQuery {
+				return []abci.RequestQuery{suite.balanceQuery()}
+			},
+			icqtypes.ErrHostDisabled,
+		},
+	}
+
+	for i, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			tc.malleate()
+
+			reqs := tc.reqs()
+			data, err := icqtypes.SerializeCosmosQuery(reqs)
+			suite.Require().NoError(err)
+
+			packet := suite.commitICQPacket(icqtypes.InterchainQueryPacketData{Data: data}.GetBytes())
+
+			res, err := suite.path.EndpointB.RecvPacketWithResult(packet)
+			suite.Require().NoError(err)
+
+			ackBz, err := ibctesting.ParseAckFromEvents(res.GetEvents())
+			suite.Require().NoError(err)
+
+			var ack channeltypes.Acknowledgement
+			suite.Require().NoError(channeltypes.SubModuleCdc.UnmarshalJSON(ackBz, &ack))
+
+			if tc.expErr != nil {
+				suite.Require().False(ack.Success(), "test case %d", i)
+				suite.Require().Equal(channeltypes.NewErrorAcknowledgement(tc.expErr), ack)
+				return
+			}
+			suite.Require().True(ack.Success(), "test case %d: %s", i, ack.GetError())
+
+			var icqAck icqtypes.InterchainQueryPacketAck
+			suite.Require().NoError(icqtypes.ModuleCdc.UnmarshalJSON(ack.GetResult(), &icqAck))
+
+			resps, err := icqtypes.DeserializeCosmosResponse(icqAck.Data)
+			suite.Require().NoError(err)
+			suite.Require().Len(resps, len(reqs))
+
+			var balanceRes banktypes.QueryBalanceResponse
+			suite.Require().NoError(getApp(suite.chainB).AppCodec().Unmarshal(resps[0].Value, &balanceRes))
+			expBalance := getApp(suite.chainB).BankKeeper.GetBalance(suite.chainB.GetContext(), suite.chainB.SenderAccount.GetAddress(), sdk.DefaultBondDenom)
+			suite.Require().Equal(expBalance, *balanceRes.Balance)
+
+			var validatorRes stakingtypes.QueryValidatorResponse
+			suite.Require().NoError(getApp(suite.chainB).AppCodec().Unmarshal(resps[1].Value, &validatorRes))
+			suite.Require().Equal(suite.validatorAddress(), validatorRes.Validator.OperatorAddress)
+		})
+	}
+}
+
+// balanceQuery returns a bank balance query for the chainB sender account
+func (suite *ICQTestSuite) balanceQuery() abci.RequestQuery {
+	return abci.RequestQuery{
+		Path: "/cosmos.bank.v1beta1.Query/Balance",
+		Data: getApp(suite.chainB).AppCodec().MustMarshal(&banktypes.QueryBalanceRequest{
+			Address: suite.chainB.SenderAccount.GetAddress().String(),
+			Denom:   sdk.DefaultBondDenom,
+		}),
+	}
+}
+
+// validatorQuery returns a staking validator query for a chainB validator
+func (suite *ICQTestSuite) validatorQuery() abci.RequestQuery {
+	return abci.RequestQuery{
+		Path: "/cosmos.staking.v1beta1.Query/Validator",
+		Data: getApp(suite.chainB).AppCodec().MustMarshal(&stakingtypes.QueryValidatorRequest{
+			ValidatorAddr: suite.validatorAddress(),
+		}),
+	}
+}
+
+func (suite *ICQTestSuite) validatorAddress() string {
+	return getApp(suite.chainB).StakingKeeper.GetAllValidators(suite.chainB.GetContext())[0].OperatorAddress
+}
+
+// commitICQPacket writes the commitment of an ICQ packet with the given data
+// to the chainA store, as done by an ICQ controller sending the packet.
+func (suite *ICQTestSuite) commitICQPacket(data []byte) channeltypes.Packet {
+	packet := channeltypes.NewPacket(
+		data,
+		1,
+		suite.path.EndpointA.ChannelConfig.PortID,
+		suite.path.EndpointA.ChannelID,
+		suite.path.EndpointB.ChannelConfig.PortID,
+		suite.path.EndpointB.ChannelID,
+		clienttypes.NewHeight(1, 100),
+		0,
+	)
+
+	commitment := channeltypes.CommitPacket(getApp(suite.chainA).AppCodec(), packet)
+	getApp(suite.chainA).IBCKeeper.ChannelKeeper.SetPacketCommitment(suite.chainA.GetContext(), packet.SourcePort, packet.SourceChannel, packet.Sequence, commitment)
+	suite.coordinator.CommitBlock(suite.chainA)
+	suite.Require().NoError(suite.path.EndpointB.UpdateClient())
+
+	return packet
+}
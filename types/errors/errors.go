@@ -34,4 +34,36 @@ var (
 
 	// ErrInsufficientStake is used when the account has insufficient staked tokens.
 	ErrInsufficientStake = errorsmod.Register(codespace, 9, "insufficient stake")
+
+	// ErrExtensionOptionsLimit is used when the extension options of a tx
+	// exceed the limits of the node.
+	ErrExtensionOptionsLimit = errorsmod.Register(codespace, 10, "extension options limit exceeded")
+
+	// ErrMempoolFull is used when the mempool is full of txs with a higher
+	// priority.
+	ErrMempoolFull = errorsmod.Register(codespace, 11, "mempool is full")
+
+	// ErrMempoolSenderLimit is used when the sender of a tx has too many
+	// pending txs in the mempool.
+	ErrMempoolSenderLimit = errorsmod.Register(codespace, 12, "too many pending txs for sender")
+
+	// ErrCircuitBreakerTripped is used when a tx contains a message type
+	// disabled by the circuit breaker.
+	ErrCircuitBreakerTripped = errorsmod.Register(codespace, 13, "message type disabled by the circuit breaker")
+
+	// ErrTxRateLimited is used when the fee payer of a tx exceeds its txs or
+	// gas limit in the current rate limit window.
+	ErrTxRateLimited = errorsmod.Register(codespace, 14, "account tx rate limit exceeded")
+
+	// ErrTxGasLimit is used when the gas limit of a tx exceeds the maximum
+	// gas of a tx.
+	ErrTxGasLimit = errorsmod.Register(codespace, 15, "tx gas limit exceeded")
+
+	// ErrTooManyMsgs is used when a tx has more msgs than allowed, including
+	// the nested msgs.
+	ErrTooManyMsgs = errorsmod.Register(codespace, 16, "too many msgs in tx")
+
+	// ErrMsgsNestedTooDeep is used when the msgs of a tx are nested deeper
+	// than allowed.
+	ErrMsgsNestedTooDeep = errorsmod.Register(codespace, 17, "msgs nested too deep")
 )
@@ -0,0 +1,9 @@
+package circuitbreaker
+
+import (
+	"github.com/cosmos/gaia/v17/x/circuitbreaker/types"
+)
+
+const (
+	ModuleName = types.ModuleName
+)
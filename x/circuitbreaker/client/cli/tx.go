@@ -0,0 +1,91 @@
+package cli
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+
+	"github.com/cosmos/gaia/v17/x/circuitbreaker/types"
+)
+
+const (
+	FlagBlocks = "blocks"
+	FlagReason = "reason"
+)
+
+func GetTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Circuit breaker transactions subcommands for the guardians",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	txCmd.AddCommand(
+		GetCmdTrip(),
+		GetCmdReset(),
+	)
+	return txCmd
+}
+
+func GetCmdTrip() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "trip [msg-type-url]...",
+		Short: "Disable message types as a guardian",
+		Long: "Disable message types for a number of blocks, at most the max_guardian_trip_blocks param. " +
+			"The signer must be a guardian; governance trips the circuit breaker with a proposal.",
+		Example: "gaiad tx circuitbreaker trip /cosmos.bank.v1beta1.MsgSend --blocks 1000 --reason \"bank exploit\" --from guardian",
+		Args:    cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			blocks, err := cmd.Flags().GetUint64(FlagBlocks)
+			if err != nil {
+				return err
+			}
+			reason, err := cmd.Flags().GetString(FlagReason)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgTripCircuitBreaker(clientCtx.GetFromAddress().String(), args, blocks, reason)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().Uint64(FlagBlocks, 0, "Number of blocks the message types are disabled for")
+	cmd.Flags().String(FlagReason, "", "Reason of the trip")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdReset() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reset [msg-type-url]...",
+		Short: "Enable message types again as a guardian",
+		Long: "Enable message types disabled by a guardian. The signer must be a guardian; " +
+			"the message types disabled by governance can only be enabled again by governance.",
+		Example: "gaiad tx circuitbreaker reset /cosmos.bank.v1beta1.MsgSend --from guardian",
+		Args:    cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgResetCircuitBreaker(clientCtx.GetFromAddress().String(), args)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
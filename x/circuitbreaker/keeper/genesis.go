@@ -0,0 +1,21 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/gaia/v17/x/circuitbreaker/types"
+)
+
+// InitGenesis initializes the circuit breaker module state from the provided genesis state
+func (k Keeper) InitGenesis(ctx sdk.Context, state types.GenesisState) {
+	k.SetParams(ctx, state.Params)
+
+	for _, tripped := range state.TrippedMsgTypes {
+		k.SetTrippedMsgType(ctx, tripped)
+	}
+}
+
+// ExportGenesis exports the circuit breaker module state
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	return types.NewGenesisState(k.GetParams(ctx), k.GetAllTrippedMsgTypes(ctx))
+}
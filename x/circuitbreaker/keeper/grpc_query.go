@@ -0,0 +1,32 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/gaia/v17/x/circuitbreaker/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// Params returns the circuit breaker params
+func (k Keeper) Params(stdCtx context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(stdCtx)
+
+	return &types.QueryParamsResponse{Params: k.GetParams(ctx)}, nil
+}
+
+// TrippedMsgTypes returns the message types rejected at the current height
+func (k Keeper) TrippedMsgTypes(stdCtx context.Context, _ *types.QueryTrippedMsgTypesRequest) (*types.QueryTrippedMsgTypesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(stdCtx)
+
+	res := []types.TrippedMsgType{}
+	for _, tripped := range k.GetAllTrippedMsgTypes(ctx) {
+		if tripped.IsActive(ctx.BlockHeight()) {
+			res = append(res, tripped)
+		}
+	}
+
+	return &types.QueryTrippedMsgTypesResponse{TrippedMsgTypes: res}, nil
+}
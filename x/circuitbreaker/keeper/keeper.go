@@ -0,0 +1,236 @@
+package keeper
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/cometbft/cometbft/libs/log"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	gaiaerrors "github.com/cosmos/gaia/v17/types/errors"
+	"github.com/cosmos/gaia/v17/x/circuitbreaker/types"
+)
+
+// Keeper stores the message types rejected by the circuit breaker, tripped
+// by governance or by a guardian.
+type Keeper struct {
+	storeKey storetypes.StoreKey
+	cdc      codec.BinaryCodec
+
+	msgRouter types.MsgRouter
+
+	// the address capable of executing a MsgUpdateParams message, and of
+	// tripping the circuit breaker without expiry, typically the x/gov
+	// module account
+	authority string
+}
+
+// NewKeeper creates a new circuit breaker Keeper instance
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	key storetypes.StoreKey,
+	msgRouter types.MsgRouter,
+	authority string,
+) Keeper {
+	return Keeper{
+		storeKey:  key,
+		cdc:       cdc,
+		msgRouter: msgRouter,
+		authority: authority,
+	}
+}
+
+// Logger returns the application logger, scoped to the associated module
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// GetAuthority returns the x/circuitbreaker module's authority
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// GetParams returns the circuit breaker params
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ParamsKey)
+	if bz == nil {
+		return types.DefaultParams()
+	}
+
+	k.cdc.MustUnmarshal(bz, &params)
+	return params
+}
+
+// SetParams sets the circuit breaker params
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ParamsKey, k.cdc.MustMarshal(&params))
+}
+
+// GetTrippedMsgType returns the given tripped message type, even if expired
+func (k Keeper) GetTrippedMsgType(ctx sdk.Context, msgTypeURL string) (tripped types.TrippedMsgType, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.TrippedMsgTypeKey(msgTypeURL))
+	if bz == nil {
+		return tripped, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &tripped)
+	return tripped, true
+}
+
+// SetTrippedMsgType stores the tripped message type
+func (k Keeper) SetTrippedMsgType(ctx sdk.Context, tripped types.TrippedMsgType) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.TrippedMsgTypeKey(tripped.MsgTypeUrl), k.cdc.MustMarshal(&tripped))
+}
+
+// DeleteTrippedMsgType deletes the tripped message type
+func (k Keeper) DeleteTrippedMsgType(ctx sdk.Context, msgTypeURL string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.TrippedMsgTypeKey(msgTypeURL))
+}
+
+// GetAllTrippedMsgTypes returns every tripped message type, sorted by type
+// URL, including the ones expired in the current block
+func (k Keeper) GetAllTrippedMsgTypes(ctx sdk.Context) (tripped []types.TrippedMsgType) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.TrippedMsgTypePrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var t types.TrippedMsgType
+		k.cdc.MustUnmarshal(iterator.Value(), &t)
+		tripped = append(tripped, t)
+	}
+
+	return tripped
+}
+
+// IsTripped returns true if the message type is rejected at the current
+// height
+func (k Keeper) IsTripped(ctx sdk.Context, msgTypeURL string) bool {
+	tripped, found := k.GetTrippedMsgType(ctx, msgTypeURL)
+	return found && tripped.IsActive(ctx.BlockHeight())
+}
+
+// Trip rejects the message types for the given number of blocks, or until
+// reset if blocks is 0. Governance can trip the circuit breaker without
+// limit, a guardian for at most MaxGuardianTripBlocks and cannot override a
+// trip by governance.
+func (k Keeper) Trip(ctx sdk.Context, authority string, msgTypeURLs []string, blocks uint64, reason string) error {
+	isGov := authority == k.authority
+	if !isGov {
+		params := k.GetParams(ctx)
+		if !params.IsGuardian(authority) {
+			return errorsmod.Wrapf(gaiaerrors.ErrUnauthorized, "%s is neither the governance account nor a guardian", authority)
+		}
+		if blocks == 0 || blocks > params.MaxGuardianTripBlocks {
+			return errorsmod.Wrapf(gaiaerrors.ErrUnauthorized, "a guardian can trip the circuit breaker for 1 to %d blocks, got %d", params.MaxGuardianTripBlocks, blocks)
+		}
+	}
+
+	var expiryHeight int64
+	if blocks > 0 {
+		expiryHeight = ctx.BlockHeight() + int64(blocks)
+		if expiryHeight <= ctx.BlockHeight() {
+			return errorsmod.Wrapf(gaiaerrors.ErrInvalidType, "invalid number of blocks %d", blocks)
+		}
+	}
+
+	for _, msgTypeURL := range msgTypeURLs {
+		if err := types.ValidateMsgTypeURL(msgTypeURL); err != nil {
+			return err
+		}
+		if k.msgRouter.HandlerByTypeURL(msgTypeURL) == nil {
+			return errorsmod.Wrapf(gaiaerrors.ErrNotFound, "unknown message type %s", msgTypeURL)
+		}
+		if err := k.checkOverride(ctx, authority, msgTypeURL); err != nil {
+			return err
+		}
+
+		k.SetTrippedMsgType(ctx, types.TrippedMsgType{
+			MsgTypeUrl:    msgTypeURL,
+			TrippedBy:     authority,
+			Reason:        reason,
+			TrippedHeight: ctx.BlockHeight(),
+			ExpiryHeight:  expiryHeight,
+		})
+
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeTrip,
+			sdk.NewAttribute(types.AttributeKeyMsgTypeURL, msgTypeURL),
+			sdk.NewAttribute(types.AttributeKeyAuthority, authority),
+			sdk.NewAttribute(types.AttributeKeyExpiryHeight, strconv.FormatInt(expiryHeight, 10)),
+		))
+		k.Logger(ctx).Info("circuit breaker tripped", "msg type", msgTypeURL, "by", authority, "expiry height", expiryHeight, "reason", reason)
+	}
+
+	return nil
+}
+
+// Reset accepts the message types again. A guardian cannot reset a trip by
+// governance.
+func (k Keeper) Reset(ctx sdk.Context, authority string, msgTypeURLs []string) error {
+	if authority != k.authority && !k.GetParams(ctx).IsGuardian(authority) {
+		return errorsmod.Wrapf(gaiaerrors.ErrUnauthorized, "%s is neither the governance account nor a guardian", authority)
+	}
+
+	for _, msgTypeURL := range msgTypeURLs {
+		if !k.IsTripped(ctx, msgTypeURL) {
+			return errorsmod.Wrapf(gaiaerrors.ErrNotFound, "message type %s is not tripped", msgTypeURL)
+		}
+		if err := k.checkOverride(ctx, authority, msgTypeURL); err != nil {
+			return err
+		}
+
+		k.DeleteTrippedMsgType(ctx, msgTypeURL)
+
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeReset,
+			sdk.NewAttribute(types.AttributeKeyMsgTypeURL, msgTypeURL),
+			sdk.NewAttribute(types.AttributeKeyAuthority, authority),
+		))
+		k.Logger(ctx).Info("circuit breaker reset", "msg type", msgTypeURL, "by", authority)
+	}
+
+	return nil
+}
+
+// PruneExpired deletes the tripped message types expired at the current
+// height
+func (k Keeper) PruneExpired(ctx sdk.Context) {
+	for _, tripped := range k.GetAllTrippedMsgTypes(ctx) {
+		if tripped.IsActive(ctx.BlockHeight()) {
+			continue
+		}
+
+		k.DeleteTrippedMsgType(ctx, tripped.MsgTypeUrl)
+
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeExpire,
+			sdk.NewAttribute(types.AttributeKeyMsgTypeURL, tripped.MsgTypeUrl),
+		))
+		k.Logger(ctx).Info("circuit breaker expired", "msg type", tripped.MsgTypeUrl)
+	}
+}
+
+// checkOverride returns an error if a guardian tries to change an active trip
+// by governance.
+func (k Keeper) checkOverride(ctx sdk.Context, authority, msgTypeURL string) error {
+	if authority == k.authority {
+		return nil
+	}
+
+	tripped, found := k.GetTrippedMsgType(ctx, msgTypeURL)
+	if found && tripped.IsActive(ctx.BlockHeight()) && tripped.TrippedBy == k.authority {
+		return errorsmod.Wrapf(gaiaerrors.ErrUnauthorized, "message type %s was tripped by governance", msgTypeURL)
+	}
+
+	return nil
+}
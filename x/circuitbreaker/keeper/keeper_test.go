@@ -0,0 +1,162 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	gaiaapp "github.com/cosmos/gaia/v17/app"
+	"github.com/cosmos/gaia/v17/app/helpers"
+	gaiaerrors "github.com/cosmos/gaia/v17/types/errors"
+	"github.com/cosmos/gaia/v17/x/circuitbreaker/keeper"
+	"github.com/cosmos/gaia/v17/x/circuitbreaker/types"
+)
+
+const (
+	msgSend     = "/cosmos.bank.v1beta1.MsgSend"
+	msgDelegate = "/cosmos.staking.v1beta1.MsgDelegate"
+)
+
+var (
+	govAuthority = authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	guardian     = sdk.AccAddress("guardian____________").String()
+	other        = sdk.AccAddress("other_______________").String()
+)
+
+func setup(t *testing.T) (*gaiaapp.GaiaApp, sdk.Context, types.MsgServer) {
+	t.Helper()
+
+	gaiaApp := helpers.Setup(t)
+	ctx := gaiaApp.NewUncachedContext(false, tmproto.Header{Height: 10})
+	gaiaApp.CircuitBreakerKeeper.SetParams(ctx, types.NewParams([]string{guardian}, 100))
+
+	return gaiaApp, ctx, keeper.NewMsgServerImpl(gaiaApp.CircuitBreakerKeeper)
+}
+
+func TestTrip(t *testing.T) {
+	gaiaApp, ctx, msgServer := setup(t)
+	k := gaiaApp.CircuitBreakerKeeper
+
+	// governance trips the circuit breaker until reset
+	_, err := msgServer.TripCircuitBreaker(ctx, types.NewMsgTripCircuitBreaker(govAuthority, []string{msgSend}, 0, "exploit"))
+	require.NoError(t, err)
+	require.True(t, k.IsTripped(ctx, msgSend))
+	require.False(t, k.IsTripped(ctx, msgDelegate))
+	tripped, found := k.GetTrippedMsgType(ctx, msgSend)
+	require.True(t, found)
+	require.Equal(t, types.TrippedMsgType{
+		MsgTypeUrl: msgSend, TrippedBy: govAuthority, Reason: "exploit", TrippedHeight: 10,
+	}, tripped)
+
+	// a guardian trips it for a limited number of blocks
+	_, err = msgServer.TripCircuitBreaker(ctx, types.NewMsgTripCircuitBreaker(guardian, []string{msgDelegate}, 100, ""))
+	require.NoError(t, err)
+	tripped, _ = k.GetTrippedMsgType(ctx, msgDelegate)
+	require.Equal(t, int64(110), tripped.ExpiryHeight)
+
+	for _, tc := range []struct {
+		name string
+		msg  *types.MsgTripCircuitBreaker
+		err  error
+	}{
+		{"not a guardian", types.NewMsgTripCircuitBreaker(other, []string{msgDelegate}, 10, ""), gaiaerrors.ErrUnauthorized},
+		{"guardian without expiry", types.NewMsgTripCircuitBreaker(guardian, []string{msgDelegate}, 0, ""), gaiaerrors.ErrUnauthorized},
+		{"guardian above max blocks", types.NewMsgTripCircuitBreaker(guardian, []string{msgDelegate}, 101, ""), gaiaerrors.ErrUnauthorized},
+		{"guardian overriding governance", types.NewMsgTripCircuitBreaker(guardian, []string{msgSend}, 10, ""), gaiaerrors.ErrUnauthorized},
+		{"unknown msg type", types.NewMsgTripCircuitBreaker(govAuthority, []string{"/cosmos.bank.v1beta1.MsgUnknown"}, 0, ""), gaiaerrors.ErrNotFound},
+		{"protected msg type", types.NewMsgTripCircuitBreaker(govAuthority, []string{"/cosmos.gov.v1.MsgVote"}, 0, ""), gaiaerrors.ErrInvalidType},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := msgServer.TripCircuitBreaker(ctx, tc.msg)
+			require.ErrorIs(t, err, tc.err)
+		})
+	}
+
+	// the trip of the guardian expires
+	k.PruneExpired(ctx.WithBlockHeight(109))
+	require.True(t, k.IsTripped(ctx.WithBlockHeight(109), msgDelegate))
+	require.False(t, k.IsTripped(ctx.WithBlockHeight(110), msgDelegate))
+	k.PruneExpired(ctx.WithBlockHeight(110))
+	_, found = k.GetTrippedMsgType(ctx, msgDelegate)
+	require.False(t, found)
+	require.True(t, k.IsTripped(ctx.WithBlockHeight(110), msgSend))
+}
+
+func TestReset(t *testing.T) {
+	gaiaApp, ctx, msgServer := setup(t)
+	k := gaiaApp.CircuitBreakerKeeper
+
+	require.NoError(t, k.Trip(ctx, govAuthority, []string{msgSend}, 0, ""))
+	require.NoError(t, k.Trip(ctx, guardian, []string{msgDelegate}, 10, ""))
+
+	// a guardian cannot reset a trip by governance
+	_, err := msgServer.ResetCircuitBreaker(ctx, types.NewMsgResetCircuitBreaker(guardian, []string{msgSend}))
+	require.ErrorIs(t, err, gaiaerrors.ErrUnauthorized)
+	_, err = msgServer.ResetCircuitBreaker(ctx, types.NewMsgResetCircuitBreaker(other, []string{msgDelegate}))
+	require.ErrorIs(t, err, gaiaerrors.ErrUnauthorized)
+
+	_, err = msgServer.ResetCircuitBreaker(ctx, types.NewMsgResetCircuitBreaker(guardian, []string{msgDelegate}))
+	require.NoError(t, err)
+	require.False(t, k.IsTripped(ctx, msgDelegate))
+	_, err = msgServer.ResetCircuitBreaker(ctx, types.NewMsgResetCircuitBreaker(guardian, []string{msgDelegate}))
+	require.ErrorIs(t, err, gaiaerrors.ErrNotFound)
+
+	// governance can override and reset a trip by a guardian
+	require.NoError(t, k.Trip(ctx, guardian, []string{msgDelegate}, 10, ""))
+	require.NoError(t, k.Trip(ctx, govAuthority, []string{msgDelegate}, 0, ""))
+	_, err = msgServer.ResetCircuitBreaker(ctx, types.NewMsgResetCircuitBreaker(govAuthority, []string{msgSend, msgDelegate}))
+	require.NoError(t, err)
+	require.Empty(t, k.GetAllTrippedMsgTypes(ctx))
+}
+
+func TestUpdateParams(t *testing.T) {
+	gaiaApp, ctx, msgServer := setup(t)
+
+	params := types.NewParams([]string{guardian, other}, 1000)
+	_, err := msgServer.UpdateParams(ctx, types.NewMsgUpdateParams(guardian, params))
+	require.ErrorIs(t, err, gaiaerrors.ErrUnauthorized)
+
+	_, err = msgServer.UpdateParams(ctx, types.NewMsgUpdateParams(govAuthority, params))
+	require.NoError(t, err)
+	require.Equal(t, params, gaiaApp.CircuitBreakerKeeper.GetParams(ctx))
+}
+
+func TestTrippedMsgTypesQuery(t *testing.T) {
+	gaiaApp, ctx, _ := setup(t)
+	k := gaiaApp.CircuitBreakerKeeper
+
+	require.NoError(t, k.Trip(ctx, govAuthority, []string{msgSend}, 0, ""))
+	require.NoError(t, k.Trip(ctx, guardian, []string{msgDelegate}, 10, ""))
+
+	res, err := k.TrippedMsgTypes(ctx, &types.QueryTrippedMsgTypesRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.TrippedMsgTypes, 2)
+
+	// the expired trips are not returned before they are pruned
+	res, err = k.TrippedMsgTypes(ctx.WithBlockHeight(20), &types.QueryTrippedMsgTypesRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.TrippedMsgTypes, 1)
+	require.Equal(t, msgSend, res.TrippedMsgTypes[0].MsgTypeUrl)
+}
+
+func TestGenesis(t *testing.T) {
+	gaiaApp, ctx, _ := setup(t)
+	k := gaiaApp.CircuitBreakerKeeper
+
+	require.NoError(t, k.Trip(ctx, govAuthority, []string{msgSend}, 0, "exploit"))
+	require.NoError(t, k.Trip(ctx, guardian, []string{msgDelegate}, 10, ""))
+	genesis := k.ExportGenesis(ctx)
+	require.NoError(t, types.ValidateGenesis(*genesis))
+
+	newApp := helpers.Setup(t)
+	newCtx := newApp.NewUncachedContext(false, tmproto.Header{Height: 10})
+	newApp.CircuitBreakerKeeper.InitGenesis(newCtx, *genesis)
+	require.Equal(t, genesis, newApp.CircuitBreakerKeeper.ExportGenesis(newCtx))
+	require.True(t, newApp.CircuitBreakerKeeper.IsTripped(newCtx, msgDelegate))
+}
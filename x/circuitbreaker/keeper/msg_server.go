@@ -0,0 +1,61 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	gaiaerrors "github.com/cosmos/gaia/v17/types/errors"
+	"github.com/cosmos/gaia/v17/x/circuitbreaker/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the x/circuitbreaker MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+var _ types.MsgServer = msgServer{}
+
+// UpdateParams replaces the circuit breaker params. The message types tripped
+// by a former guardian stay tripped until they expire.
+func (k msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if k.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(gaiaerrors.ErrUnauthorized, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	if err := msg.Params.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	k.SetParams(ctx, msg.Params)
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
+// TripCircuitBreaker rejects the message types, see Keeper.Trip.
+func (k msgServer) TripCircuitBreaker(goCtx context.Context, msg *types.MsgTripCircuitBreaker) (*types.MsgTripCircuitBreakerResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.Trip(ctx, msg.Authority, msg.MsgTypeUrls, msg.Blocks, msg.Reason); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgTripCircuitBreakerResponse{}, nil
+}
+
+// ResetCircuitBreaker accepts the message types again, see Keeper.Reset.
+func (k msgServer) ResetCircuitBreaker(goCtx context.Context, msg *types.MsgResetCircuitBreaker) (*types.MsgResetCircuitBreakerResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.Reset(ctx, msg.Authority, msg.MsgTypeUrls); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgResetCircuitBreakerResponse{}, nil
+}
@@ -0,0 +1,62 @@
+package types
+
+import (
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	gaiaerrors "github.com/cosmos/gaia/v17/types/errors"
+)
+
+// protectedMsgTypePrefixes are the prefixes of the message types that cannot
+// be tripped, so that governance and the guardians can always reset the
+// circuit breaker.
+var protectedMsgTypePrefixes = []string{
+	"/cosmos.gov.",
+	"/gaia.circuitbreaker.",
+}
+
+// ValidateMsgTypeURL checks that the message type URL is well formed and can
+// be tripped.
+func ValidateMsgTypeURL(msgTypeURL string) error {
+	if !strings.HasPrefix(msgTypeURL, "/") || len(msgTypeURL) == 1 {
+		return errorsmod.Wrapf(gaiaerrors.ErrInvalidType, "invalid message type URL %q: must start with '/'", msgTypeURL)
+	}
+
+	for _, prefix := range protectedMsgTypePrefixes {
+		if strings.HasPrefix(msgTypeURL, prefix) {
+			return errorsmod.Wrapf(gaiaerrors.ErrInvalidType, "message type %s cannot be tripped", msgTypeURL)
+		}
+	}
+
+	return nil
+}
+
+// IsActive returns true if the message type is rejected at the given height.
+func (t TrippedMsgType) IsActive(height int64) bool {
+	return t.ExpiryHeight == 0 || height < t.ExpiryHeight
+}
+
+// ValidateBasic performs basic validation on a tripped message type.
+func (t TrippedMsgType) ValidateBasic() error {
+	if err := ValidateMsgTypeURL(t.MsgTypeUrl); err != nil {
+		return err
+	}
+
+	if _, err := sdk.AccAddressFromBech32(t.TrippedBy); err != nil {
+		return errorsmod.Wrapf(err, "tripped by %s", t.TrippedBy)
+	}
+
+	if t.TrippedHeight < 0 || t.ExpiryHeight < 0 {
+		return errorsmod.Wrapf(gaiaerrors.ErrInvalidType, "message type %s: heights cannot be negative", t.MsgTypeUrl)
+	}
+
+	if t.ExpiryHeight != 0 && t.ExpiryHeight <= t.TrippedHeight {
+		return errorsmod.Wrapf(gaiaerrors.ErrInvalidType, "message type %s: expiry height %d must be after tripped height %d",
+			t.MsgTypeUrl, t.ExpiryHeight, t.TrippedHeight)
+	}
+
+	return nil
+}
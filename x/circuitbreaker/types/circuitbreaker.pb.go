@@ -0,0 +1,726 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: gaia/circuitbreaker/v1beta1/circuitbreaker.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params defines the accounts allowed to trip the circuit breaker besides
+// governance.
+type Params struct {
+	// guardians are the accounts, typically multisig accounts, allowed to trip
+	// and reset the circuit breaker.
+	Guardians []string `protobuf:"bytes,1,rep,name=guardians,proto3" json:"guardians,omitempty"`
+	// max_guardian_trip_blocks is the maximum number of blocks a guardian can
+	// trip the circuit breaker for.
+	MaxGuardianTripBlocks uint64 `protobuf:"varint,2,opt,name=max_guardian_trip_blocks,json=maxGuardianTripBlocks,proto3" json:"max_guardian_trip_blocks,omitempty"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e9e0aefba80b96f7, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetGuardians() []string {
+	if m != nil {
+		return m.Guardians
+	}
+	return nil
+}
+
+func (m *Params) GetMaxGuardianTripBlocks() uint64 {
+	if m != nil {
+		return m.MaxGuardianTripBlocks
+	}
+	return 0
+}
+
+// TrippedMsgType is a message type rejected by the circuit breaker.
+type TrippedMsgType struct {
+	// msg_type_url is the type URL of the rejected message, e.g.
+	// "/cosmos.staking.v1beta1.MsgTokenizeShares".
+	MsgTypeUrl string `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	// tripped_by is the governance account or the guardian that tripped the
+	// circuit breaker.
+	TrippedBy string `protobuf:"bytes,2,opt,name=tripped_by,json=trippedBy,proto3" json:"tripped_by,omitempty"`
+	// reason is a human readable explanation of why the message type is
+	// rejected.
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	// tripped_height is the height the circuit breaker was tripped at.
+	TrippedHeight int64 `protobuf:"varint,4,opt,name=tripped_height,json=trippedHeight,proto3" json:"tripped_height,omitempty"`
+	// expiry_height is the height from which the message type is accepted
+	// again, 0 if it is rejected until reset.
+	ExpiryHeight int64 `protobuf:"varint,5,opt,name=expiry_height,json=expiryHeight,proto3" json:"expiry_height,omitempty"`
+}
+
+func (m *TrippedMsgType) Reset()         { *m = TrippedMsgType{} }
+func (m *TrippedMsgType) String() string { return proto.CompactTextString(m) }
+func (*TrippedMsgType) ProtoMessage()    {}
+func (*TrippedMsgType) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e9e0aefba80b96f7, []int{1}
+}
+func (m *TrippedMsgType) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TrippedMsgType) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TrippedMsgType.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TrippedMsgType) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TrippedMsgType.Merge(m, src)
+}
+func (m *TrippedMsgType) XXX_Size() int {
+	return m.Size()
+}
+func (m *TrippedMsgType) XXX_DiscardUnknown() {
+	xxx_messageInfo_TrippedMsgType.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TrippedMsgType proto.InternalMessageInfo
+
+func (m *TrippedMsgType) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
+func (m *TrippedMsgType) GetTrippedBy() string {
+	if m != nil {
+		return m.TrippedBy
+	}
+	return ""
+}
+
+func (m *TrippedMsgType) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *TrippedMsgType) GetTrippedHeight() int64 {
+	if m != nil {
+		return m.TrippedHeight
+	}
+	return 0
+}
+
+func (m *TrippedMsgType) GetExpiryHeight() int64 {
+	if m != nil {
+		return m.ExpiryHeight
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "gaia.circuitbreaker.v1beta1.Params")
+	proto.RegisterType((*TrippedMsgType)(nil), "gaia.circuitbreaker.v1beta1.TrippedMsgType")
+}
+
+func init() {
+	proto.RegisterFile("gaia/circuitbreaker/v1beta1/circuitbreaker.proto", fileDescriptor_e9e0aefba80b96f7)
+}
+
+var fileDescriptor_e9e0aefba80b96f7 = []byte{
+	// 348 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x91, 0xc1, 0x4a, 0xf3, 0x40,
+	0x1c, 0xc4, 0xbb, 0x5f, 0xfb, 0x15, 0xba, 0xb4, 0x3d, 0x2c, 0x2a, 0x51, 0x21, 0x84, 0x8a, 0x90,
+	0x4b, 0x1b, 0x8b, 0x60, 0xcf, 0xe6, 0x52, 0x2f, 0x82, 0xc4, 0x7a, 0xf1, 0x12, 0x36, 0xc9, 0x92,
+	0x2e, 0x6d, 0x9a, 0xe5, 0xbf, 0x1b, 0x49, 0xde, 0xc2, 0x87, 0xf1, 0x21, 0xbc, 0x59, 0x3c, 0x79,
+	0x94, 0xf6, 0x45, 0xa4, 0x9b, 0x2d, 0x42, 0x0f, 0x1e, 0xff, 0x33, 0xbf, 0x19, 0x92, 0x1d, 0x7c,
+	0x95, 0x52, 0x4e, 0xbd, 0x98, 0x43, 0x5c, 0x70, 0x15, 0x01, 0xa3, 0x0b, 0x06, 0xde, 0xcb, 0x38,
+	0x62, 0x8a, 0x8e, 0x0f, 0xe4, 0x91, 0x80, 0x5c, 0xe5, 0xe4, 0x7c, 0x97, 0x18, 0x1d, 0x58, 0x26,
+	0x71, 0x76, 0x1a, 0xe7, 0x32, 0xcb, 0x65, 0xa8, 0x51, 0xaf, 0x3e, 0xea, 0xdc, 0xa0, 0xc2, 0xed,
+	0x07, 0x0a, 0x34, 0x93, 0xe4, 0x06, 0x77, 0xd2, 0x82, 0x42, 0xc2, 0xe9, 0x4a, 0x5a, 0xc8, 0x69,
+	0xba, 0x1d, 0xdf, 0xfa, 0x7c, 0x1b, 0x1e, 0x19, 0xfc, 0x36, 0x49, 0x80, 0x49, 0xf9, 0xa8, 0x80,
+	0xaf, 0xd2, 0xe0, 0x17, 0x25, 0x13, 0x6c, 0x65, 0xb4, 0x0c, 0xf7, 0x42, 0xa8, 0x80, 0x8b, 0x30,
+	0x5a, 0xe6, 0xf1, 0x42, 0x5a, 0xff, 0x1c, 0xe4, 0xb6, 0x82, 0xe3, 0x8c, 0x96, 0x53, 0x63, 0xcf,
+	0x80, 0x0b, 0x5f, 0x9b, 0x83, 0x0f, 0x84, 0xfb, 0xbb, 0x53, 0xb0, 0xe4, 0x5e, 0xa6, 0xb3, 0x4a,
+	0x30, 0xe2, 0xe0, 0x6e, 0x26, 0xd3, 0x50, 0x55, 0x82, 0x85, 0x05, 0x2c, 0x2d, 0xe4, 0x20, 0xb7,
+	0x13, 0xe0, 0xac, 0xb6, 0x9f, 0x60, 0x49, 0x26, 0x18, 0xab, 0x3a, 0x13, 0x46, 0x95, 0xee, 0xff,
+	0xf3, 0x33, 0x0d, 0xeb, 0x57, 0xe4, 0x04, 0xb7, 0x81, 0x51, 0x99, 0xaf, 0xac, 0xa6, 0x2e, 0x35,
+	0x17, 0xb9, 0xc4, 0xfd, 0x7d, 0xe1, 0x9c, 0xf1, 0x74, 0xae, 0xac, 0x96, 0x83, 0xdc, 0x66, 0xd0,
+	0x33, 0xea, 0x9d, 0x16, 0xc9, 0x05, 0xee, 0xb1, 0x52, 0x70, 0xa8, 0xf6, 0xd4, 0x7f, 0x4d, 0x75,
+	0x6b, 0xb1, 0x86, 0xfc, 0xe9, 0xfb, 0xc6, 0x46, 0xeb, 0x8d, 0x8d, 0xbe, 0x37, 0x36, 0x7a, 0xdd,
+	0xda, 0x8d, 0xf5, 0xd6, 0x6e, 0x7c, 0x6d, 0xed, 0xc6, 0xf3, 0x30, 0xe5, 0x6a, 0x5e, 0x44, 0xa3,
+	0x38, 0xcf, 0xcc, 0xfb, 0x7b, 0x7a, 0xe2, 0xf2, 0x70, 0xe4, 0xdd, 0xaf, 0xcb, 0xa8, 0xad, 0xc7,
+	0xb9, 0xfe, 0x19, 0x00, 0x8d, 0x67, 0x6c, 0x2c, 0x08, 0x02, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxGuardianTripBlocks != 0 {
+		i = encodeVarintCircuitbreaker(dAtA, i, uint64(m.MaxGuardianTripBlocks))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Guardians) > 0 {
+		for iNdEx := len(m.Guardians) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Guardians[iNdEx])
+			copy(dAtA[i:], m.Guardians[iNdEx])
+			i = encodeVarintCircuitbreaker(dAtA, i, uint64(len(m.Guardians[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TrippedMsgType) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TrippedMsgType) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TrippedMsgType) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpiryHeight != 0 {
+		i = encodeVarintCircuitbreaker(dAtA, i, uint64(m.ExpiryHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.TrippedHeight != 0 {
+		i = encodeVarintCircuitbreaker(dAtA, i, uint64(m.TrippedHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintCircuitbreaker(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.TrippedBy) > 0 {
+		i -= len(m.TrippedBy)
+		copy(dAtA[i:], m.TrippedBy)
+		i = encodeVarintCircuitbreaker(dAtA, i, uint64(len(m.TrippedBy)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintCircuitbreaker(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintCircuitbreaker(dAtA []byte, offset int, v uint64) int {
+	offset -= sovCircuitbreaker(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Guardians) > 0 {
+		for _, s := range m.Guardians {
+			l = len(s)
+			n += 1 + l + sovCircuitbreaker(uint64(l))
+		}
+	}
+	if m.MaxGuardianTripBlocks != 0 {
+		n += 1 + sovCircuitbreaker(uint64(m.MaxGuardianTripBlocks))
+	}
+	return n
+}
+
+func (m *TrippedMsgType) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovCircuitbreaker(uint64(l))
+	}
+	l = len(m.TrippedBy)
+	if l > 0 {
+		n += 1 + l + sovCircuitbreaker(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovCircuitbreaker(uint64(l))
+	}
+	if m.TrippedHeight != 0 {
+		n += 1 + sovCircuitbreaker(uint64(m.TrippedHeight))
+	}
+	if m.ExpiryHeight != 0 {
+		n += 1 + sovCircuitbreaker(uint64(m.ExpiryHeight))
+	}
+	return n
+}
+
+func sovCircuitbreaker(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozCircuitbreaker(x uint64) (n int) {
+	return sovCircuitbreaker(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCircuitbreaker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Guardians", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCircuitbreaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCircuitbreaker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCircuitbreaker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Guardians = append(m.Guardians, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxGuardianTripBlocks", wireType)
+			}
+			m.MaxGuardianTripBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCircuitbreaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxGuardianTripBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCircuitbreaker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCircuitbreaker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TrippedMsgType) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCircuitbreaker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TrippedMsgType: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TrippedMsgType: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCircuitbreaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCircuitbreaker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCircuitbreaker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrippedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCircuitbreaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCircuitbreaker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCircuitbreaker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TrippedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCircuitbreaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCircuitbreaker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCircuitbreaker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrippedHeight", wireType)
+			}
+			m.TrippedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCircuitbreaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TrippedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryHeight", wireType)
+			}
+			m.ExpiryHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCircuitbreaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCircuitbreaker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCircuitbreaker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipCircuitbreaker(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowCircuitbreaker
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowCircuitbreaker
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowCircuitbreaker
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthCircuitbreaker
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupCircuitbreaker
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthCircuitbreaker
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthCircuitbreaker        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowCircuitbreaker          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupCircuitbreaker = fmt.Errorf("proto: unexpected end of group")
+)
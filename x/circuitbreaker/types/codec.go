@@ -0,0 +1,27 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+)
+
+// RegisterLegacyAminoCodec registers the x/circuitbreaker messages on the provided LegacyAmino codec
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&MsgUpdateParams{}, "gaia/x/circuitbreaker/MsgUpdateParams", nil)
+	cdc.RegisterConcrete(&MsgTripCircuitBreaker{}, "gaia/x/circuitbreaker/MsgTripCircuitBreaker", nil)
+	cdc.RegisterConcrete(&MsgResetCircuitBreaker{}, "gaia/x/circuitbreaker/MsgResetCircuitBreaker", nil)
+}
+
+// RegisterInterfaces registers the x/circuitbreaker messages on the provided InterfaceRegistry
+func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
+	registry.RegisterImplementations(
+		(*sdk.Msg)(nil),
+		&MsgUpdateParams{},
+		&MsgTripCircuitBreaker{},
+		&MsgResetCircuitBreaker{},
+	)
+
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
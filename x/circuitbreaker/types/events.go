@@ -0,0 +1,12 @@
+package types
+
+// circuit breaker module event types
+const (
+	EventTypeTrip   = "circuit_breaker_trip"
+	EventTypeReset  = "circuit_breaker_reset"
+	EventTypeExpire = "circuit_breaker_expire"
+
+	AttributeKeyMsgTypeURL   = "msg_type_url"
+	AttributeKeyAuthority    = "authority"
+	AttributeKeyExpiryHeight = "expiry_height"
+)
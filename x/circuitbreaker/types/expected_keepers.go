@@ -0,0 +1,11 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/baseapp"
+)
+
+// MsgRouter defines the expected message router, used to check that the
+// tripped message types exist.
+type MsgRouter interface {
+	HandlerByTypeURL(typeURL string) baseapp.MsgServiceHandler
+}
@@ -0,0 +1,40 @@
+package types
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+)
+
+// NewGenesisState - Create a new genesis state
+func NewGenesisState(params Params, tripped []TrippedMsgType) *GenesisState {
+	return &GenesisState{
+		Params:          params,
+		TrippedMsgTypes: tripped,
+	}
+}
+
+// DefaultGenesisState - Return a default genesis state
+func DefaultGenesisState() *GenesisState {
+	return NewGenesisState(DefaultParams(), nil)
+}
+
+func ValidateGenesis(data GenesisState) error {
+	if err := data.Params.ValidateBasic(); err != nil {
+		return errorsmod.Wrap(err, "circuitbreaker params")
+	}
+
+	msgTypes := make(map[string]struct{}, len(data.TrippedMsgTypes))
+	for _, tripped := range data.TrippedMsgTypes {
+		if err := tripped.ValidateBasic(); err != nil {
+			return errorsmod.Wrap(err, "circuitbreaker tripped message type")
+		}
+
+		if _, ok := msgTypes[tripped.MsgTypeUrl]; ok {
+			return fmt.Errorf("duplicate tripped message type %s", tripped.MsgTypeUrl)
+		}
+		msgTypes[tripped.MsgTypeUrl] = struct{}{}
+	}
+
+	return nil
+}
@@ -0,0 +1,26 @@
+package types
+
+const (
+	// ModuleName is the name of the this module
+	ModuleName = "circuitbreaker"
+
+	// StoreKey is the store key string for the circuit breaker module
+	StoreKey = ModuleName
+
+	// QuerierRoute is the querier route for the circuit breaker module
+	QuerierRoute = ModuleName
+)
+
+var (
+	// ParamsKey defines the key to store the module params in store
+	ParamsKey = []byte{0x01}
+
+	// TrippedMsgTypePrefix defines the prefix of the message types rejected
+	// by the circuit breaker
+	TrippedMsgTypePrefix = []byte{0x02}
+)
+
+// TrippedMsgTypeKey returns the store key of the given tripped message type
+func TrippedMsgTypeKey(msgTypeURL string) []byte {
+	return append(TrippedMsgTypePrefix, []byte(msgTypeURL)...)
+}
@@ -0,0 +1,103 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	gaiaerrors "github.com/cosmos/gaia/v17/types/errors"
+)
+
+var (
+	_ sdk.Msg = &MsgUpdateParams{}
+	_ sdk.Msg = &MsgTripCircuitBreaker{}
+	_ sdk.Msg = &MsgResetCircuitBreaker{}
+)
+
+// NewMsgUpdateParams creates a new MsgUpdateParams instance
+func NewMsgUpdateParams(authority string, params Params) *MsgUpdateParams {
+	return &MsgUpdateParams{
+		Authority: authority,
+		Params:    params,
+	}
+}
+
+// GetSigners implements sdk.Msg
+func (m MsgUpdateParams) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(m.Authority)}
+}
+
+// ValidateBasic implements sdk.Msg
+func (m MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "authority")
+	}
+
+	return m.Params.ValidateBasic()
+}
+
+// NewMsgTripCircuitBreaker creates a new MsgTripCircuitBreaker instance
+func NewMsgTripCircuitBreaker(authority string, msgTypeURLs []string, blocks uint64, reason string) *MsgTripCircuitBreaker {
+	return &MsgTripCircuitBreaker{
+		Authority:   authority,
+		MsgTypeUrls: msgTypeURLs,
+		Blocks:      blocks,
+		Reason:      reason,
+	}
+}
+
+// GetSigners implements sdk.Msg
+func (m MsgTripCircuitBreaker) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(m.Authority)}
+}
+
+// ValidateBasic implements sdk.Msg
+func (m MsgTripCircuitBreaker) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "authority")
+	}
+
+	return validateMsgTypeURLs(m.MsgTypeUrls)
+}
+
+// NewMsgResetCircuitBreaker creates a new MsgResetCircuitBreaker instance
+func NewMsgResetCircuitBreaker(authority string, msgTypeURLs []string) *MsgResetCircuitBreaker {
+	return &MsgResetCircuitBreaker{
+		Authority:   authority,
+		MsgTypeUrls: msgTypeURLs,
+	}
+}
+
+// GetSigners implements sdk.Msg
+func (m MsgResetCircuitBreaker) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(m.Authority)}
+}
+
+// ValidateBasic implements sdk.Msg
+func (m MsgResetCircuitBreaker) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "authority")
+	}
+
+	return validateMsgTypeURLs(m.MsgTypeUrls)
+}
+
+func validateMsgTypeURLs(msgTypeURLs []string) error {
+	if len(msgTypeURLs) == 0 {
+		return errorsmod.Wrap(gaiaerrors.ErrInvalidType, "no message type URL")
+	}
+
+	seen := make(map[string]struct{}, len(msgTypeURLs))
+	for _, msgTypeURL := range msgTypeURLs {
+		if err := ValidateMsgTypeURL(msgTypeURL); err != nil {
+			return err
+		}
+
+		if _, ok := seen[msgTypeURL]; ok {
+			return errorsmod.Wrapf(gaiaerrors.ErrInvalidType, "duplicate message type %s", msgTypeURL)
+		}
+		seen[msgTypeURL] = struct{}{}
+	}
+
+	return nil
+}
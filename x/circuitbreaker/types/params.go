@@ -0,0 +1,59 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	gaiaerrors "github.com/cosmos/gaia/v17/types/errors"
+)
+
+// DefaultMaxGuardianTripBlocks is the default maximum number of blocks a
+// guardian can trip the circuit breaker for, about a week of 6s blocks.
+const DefaultMaxGuardianTripBlocks uint64 = 100_800
+
+// NewParams creates a new Params instance
+func NewParams(guardians []string, maxGuardianTripBlocks uint64) Params {
+	return Params{
+		Guardians:             guardians,
+		MaxGuardianTripBlocks: maxGuardianTripBlocks,
+	}
+}
+
+// DefaultParams returns the default params, only governance can trip the
+// circuit breaker.
+func DefaultParams() Params {
+	return NewParams(nil, DefaultMaxGuardianTripBlocks)
+}
+
+// ValidateBasic performs basic validation on the circuit breaker params.
+func (p Params) ValidateBasic() error {
+	guardians := make(map[string]struct{}, len(p.Guardians))
+	for _, guardian := range p.Guardians {
+		if _, err := sdk.AccAddressFromBech32(guardian); err != nil {
+			return errorsmod.Wrapf(err, "guardian %s", guardian)
+		}
+
+		if _, ok := guardians[guardian]; ok {
+			return errorsmod.Wrapf(gaiaerrors.ErrInvalidType, "duplicate guardian %s", guardian)
+		}
+		guardians[guardian] = struct{}{}
+	}
+
+	if len(p.Guardians) > 0 && p.MaxGuardianTripBlocks == 0 {
+		return errorsmod.Wrap(gaiaerrors.ErrInvalidType, "max guardian trip blocks must be positive")
+	}
+
+	return nil
+}
+
+// IsGuardian returns true if the address is a guardian.
+func (p Params) IsGuardian(address string) bool {
+	for _, guardian := range p.Guardians {
+		if guardian == address {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,9 @@
+package escrow
+
+import (
+	"github.com/cosmos/gaia/v17/x/escrow/types"
+)
+
+const (
+	ModuleName = types.ModuleName
+)
@@ -0,0 +1,57 @@
+package cli
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+
+	"github.com/cosmos/gaia/v17/x/escrow/types"
+)
+
+const flagDriftOnly = "drift-only"
+
+func GetQueryCmd() *cobra.Command {
+	queryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the IBC transfer escrow accounts",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	queryCmd.AddCommand(
+		GetCmdEscrows(),
+	)
+	return queryCmd
+}
+
+func GetCmdEscrows() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "escrows",
+		Short: "Compare the transfer channel escrow accounts with the recorded total escrow",
+		Long: "Show the escrow account balance of every transfer channel and, per denom, the amount held by " +
+			"all escrow accounts next to the total escrow recorded by the transfer module",
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			driftOnly, err := cmd.Flags().GetBool(flagDriftOnly)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.Escrows(cmd.Context(), &types.QueryEscrowsRequest{DriftOnly: driftOnly})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	cmd.Flags().Bool(flagDriftOnly, false, "only show denoms whose escrowed amount differs from the recorded total escrow")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -0,0 +1,35 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/gaia/v17/x/escrow/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// Escrows returns the transfer channel escrow balances and the per denom
+// comparison with the recorded total escrow
+func (k Keeper) Escrows(stdCtx context.Context, req *types.QueryEscrowsRequest) (*types.QueryEscrowsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(stdCtx)
+
+	channels := k.GetChannelEscrows(ctx)
+	denoms := k.GetDenomEscrows(ctx, channels)
+
+	if req != nil && req.DriftOnly {
+		drifted := make([]types.DenomEscrow, 0, len(denoms))
+		for _, escrow := range denoms {
+			if !escrow.InSync() {
+				drifted = append(drifted, escrow)
+			}
+		}
+		denoms = drifted
+	}
+
+	return &types.QueryEscrowsResponse{
+		Channels: channels,
+		Denoms:   denoms,
+	}, nil
+}
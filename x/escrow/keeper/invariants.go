@@ -0,0 +1,40 @@
+package keeper
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/gaia/v17/x/escrow/types"
+)
+
+// RegisterInvariants registers all escrow invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "escrow-deficit", EscrowDeficitInvariant(k))
+}
+
+// EscrowDeficitInvariant checks that, for every denom, the transfer channel
+// escrow accounts hold at least the total escrow recorded by the transfer
+// module. A surplus does not break the invariant since anyone can send coins
+// to an escrow account.
+func EscrowDeficitInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			broken bool
+			msg    strings.Builder
+		)
+
+		for _, escrow := range k.GetDenomEscrows(ctx, k.GetChannelEscrows(ctx)) {
+			if escrow.IsDeficit() {
+				broken = true
+				msg.WriteString(fmt.Sprintf("\tdenom %s: escrowed %s, recorded total escrow %s\n", escrow.Denom, escrow.Escrowed, escrow.TotalEscrow))
+			}
+		}
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "escrow-deficit",
+			fmt.Sprintf("found denom(s) with escrow accounts holding less than the recorded total escrow:\n%s", msg.String()),
+		), broken
+	}
+}
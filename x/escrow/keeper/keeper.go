@@ -0,0 +1,105 @@
+package keeper
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/cometbft/cometbft/libs/log"
+
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/gaia/v17/x/escrow/types"
+)
+
+// Keeper compares the transfer channel escrow accounts with the total escrow
+// recorded by the transfer module and reconciles them on behalf of governance.
+type Keeper struct {
+	bankKeeper     types.BankKeeper
+	transferKeeper types.TransferKeeper
+	channelKeeper  types.ChannelKeeper
+
+	// the address capable of executing a MsgReconcileEscrow message, typically
+	// the x/gov module account
+	authority string
+}
+
+// NewKeeper creates a new escrow Keeper instance
+func NewKeeper(
+	bankKeeper types.BankKeeper,
+	transferKeeper types.TransferKeeper,
+	channelKeeper types.ChannelKeeper,
+	authority string,
+) Keeper {
+	return Keeper{
+		bankKeeper:     bankKeeper,
+		transferKeeper: transferKeeper,
+		channelKeeper:  channelKeeper,
+		authority:      authority,
+	}
+}
+
+// Logger returns the application logger, scoped to the associated module
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// GetAuthority returns the x/escrow module's authority
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// GetChannelEscrows returns the escrow account balance of every channel bound
+// to the transfer port.
+func (k Keeper) GetChannelEscrows(ctx sdk.Context) []types.ChannelEscrow {
+	portID := k.transferKeeper.GetPort(ctx)
+
+	var escrows []types.ChannelEscrow
+	for _, channel := range k.channelKeeper.GetAllChannelsWithPortPrefix(ctx, portID) {
+		// the port prefix may also match other ports, e.g. "transfer-foo"
+		if channel.PortId != portID {
+			continue
+		}
+
+		escrowAddress := transfertypes.GetEscrowAddress(channel.PortId, channel.ChannelId)
+		escrows = append(escrows, types.ChannelEscrow{
+			PortId:        channel.PortId,
+			ChannelId:     channel.ChannelId,
+			EscrowAddress: escrowAddress.String(),
+			Balance:       k.bankKeeper.GetAllBalances(ctx, escrowAddress),
+		})
+	}
+
+	return escrows
+}
+
+// GetDenomEscrows compares, for every denom either held by a channel escrow
+// account or recorded by the transfer module, the escrowed amount with the
+// recorded total escrow. The result is sorted by denom.
+func (k Keeper) GetDenomEscrows(ctx sdk.Context, channelEscrows []types.ChannelEscrow) []types.DenomEscrow {
+	var escrowed sdk.Coins
+	for _, channel := range channelEscrows {
+		escrowed = escrowed.Add(channel.Balance...)
+	}
+	totalEscrow := k.transferKeeper.GetAllTotalEscrowed(ctx)
+
+	denoms := make(map[string]struct{})
+	for _, coin := range escrowed.Add(totalEscrow...) {
+		denoms[coin.Denom] = struct{}{}
+	}
+
+	escrows := make([]types.DenomEscrow, 0, len(denoms))
+	for denom := range denoms {
+		escrows = append(escrows, types.DenomEscrow{
+			Denom:       denom,
+			Escrowed:    escrowed.AmountOf(denom),
+			TotalEscrow: totalEscrow.AmountOf(denom),
+		})
+	}
+	sort.Slice(escrows, func(i, j int) bool {
+		return escrows[i].Denom < escrows[j].Denom
+	})
+
+	return escrows
+}
@@ -0,0 +1,190 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	gaiaapp "github.com/cosmos/gaia/v17/app"
+	"github.com/cosmos/gaia/v17/app/helpers"
+	gaiaerrors "github.com/cosmos/gaia/v17/types/errors"
+	"github.com/cosmos/gaia/v17/x/escrow/keeper"
+	"github.com/cosmos/gaia/v17/x/escrow/types"
+)
+
+const testDenom = "uatom"
+
+// setupChannels opens the given transfer channels and funds their escrow accounts
+// while keeping the recorded total escrow in sync
+func setupChannels(t *testing.T, gaiaApp *gaiaapp.GaiaApp, ctx sdk.Context, escrows map[string]sdk.Coins) {
+	t.Helper()
+
+	for channelID, coins := range escrows {
+		gaiaApp.IBCKeeper.ChannelKeeper.SetChannel(ctx, transfertypes.PortID, channelID, channeltypes.NewChannel(
+			channeltypes.OPEN, channeltypes.UNORDERED,
+			channeltypes.NewCounterparty(transfertypes.PortID, channelID),
+			[]string{"connection-0"}, transfertypes.Version,
+		))
+
+		escrowAddress := transfertypes.GetEscrowAddress(transfertypes.PortID, channelID)
+		require.NoError(t, banktestutil.FundAccount(gaiaApp.BankKeeper, ctx, escrowAddress, coins))
+
+		for _, coin := range coins {
+			total := gaiaApp.TransferKeeper.GetTotalEscrowForDenom(ctx, coin.Denom)
+			gaiaApp.TransferKeeper.SetTotalEscrowForDenom(ctx, total.Add(coin))
+		}
+	}
+}
+
+func TestEscrowsQuery(t *testing.T) {
+	gaiaApp := helpers.Setup(t)
+	ctx := gaiaApp.NewUncachedContext(true, tmproto.Header{})
+
+	setupChannels(t, gaiaApp, ctx, map[string]sdk.Coins{
+		"channel-0": sdk.NewCoins(sdk.NewInt64Coin(testDenom, 100)),
+		"channel-1": sdk.NewCoins(sdk.NewInt64Coin(testDenom, 50), sdk.NewInt64Coin("ibc/ABC", 10)),
+	})
+
+	res, err := gaiaApp.EscrowKeeper.Escrows(sdk.WrapSDKContext(ctx), &types.QueryEscrowsRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.Channels, 2)
+	require.Equal(t, transfertypes.GetEscrowAddress(transfertypes.PortID, "channel-0").String(), res.Channels[0].EscrowAddress)
+	require.Equal(t, []types.DenomEscrow{
+		{Denom: "ibc/ABC", Escrowed: sdk.NewInt(10), TotalEscrow: sdk.NewInt(10)},
+		{Denom: testDenom, Escrowed: sdk.NewInt(150), TotalEscrow: sdk.NewInt(150)},
+	}, res.Denoms)
+
+	res, err = gaiaApp.EscrowKeeper.Escrows(sdk.WrapSDKContext(ctx), &types.QueryEscrowsRequest{DriftOnly: true})
+	require.NoError(t, err)
+	require.Empty(t, res.Denoms)
+
+	// record more than what is escrowed
+	gaiaApp.TransferKeeper.SetTotalEscrowForDenom(ctx, sdk.NewInt64Coin(testDenom, 200))
+
+	res, err = gaiaApp.EscrowKeeper.Escrows(sdk.WrapSDKContext(ctx), &types.QueryEscrowsRequest{DriftOnly: true})
+	require.NoError(t, err)
+	require.Equal(t, []types.DenomEscrow{
+		{Denom: testDenom, Escrowed: sdk.NewInt(150), TotalEscrow: sdk.NewInt(200)},
+	}, res.Denoms)
+}
+
+func TestEscrowDeficitInvariant(t *testing.T) {
+	gaiaApp := helpers.Setup(t)
+	ctx := gaiaApp.NewUncachedContext(true, tmproto.Header{})
+	invariant := keeper.EscrowDeficitInvariant(gaiaApp.EscrowKeeper)
+
+	setupChannels(t, gaiaApp, ctx, map[string]sdk.Coins{
+		"channel-0": sdk.NewCoins(sdk.NewInt64Coin(testDenom, 100)),
+	})
+
+	_, broken := invariant(ctx)
+	require.False(t, broken)
+
+	// a surplus, e.g. coins sent directly to the escrow account, does not break the invariant
+	escrowAddress := transfertypes.GetEscrowAddress(transfertypes.PortID, "channel-0")
+	require.NoError(t, banktestutil.FundAccount(gaiaApp.BankKeeper, ctx, escrowAddress, sdk.NewCoins(sdk.NewInt64Coin(testDenom, 1))))
+
+	_, broken = invariant(ctx)
+	require.False(t, broken)
+
+	// a deficit does
+	gaiaApp.TransferKeeper.SetTotalEscrowForDenom(ctx, sdk.NewInt64Coin(testDenom, 102))
+
+	msg, broken := invariant(ctx)
+	require.True(t, broken)
+	require.Contains(t, msg, "denom uatom: escrowed 101, recorded total escrow 102")
+}
+
+func TestReconcileEscrow(t *testing.T) {
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	testCases := []struct {
+		name          string
+		msg           *types.MsgReconcileEscrow
+		expErr        error
+		expReconciled []types.DenomEscrow
+		expEscrow     sdk.Coins
+		expTotal      sdk.Coins
+	}{
+		{
+			name:          "sync recorded total escrow to the escrow accounts",
+			msg:           types.NewMsgReconcileEscrow(authority, "", nil),
+			expReconciled: []types.DenomEscrow{{Denom: testDenom, Escrowed: sdk.NewInt(100), TotalEscrow: sdk.NewInt(150)}},
+			expEscrow:     sdk.NewCoins(sdk.NewInt64Coin(testDenom, 100)),
+			expTotal:      sdk.NewCoins(sdk.NewInt64Coin(testDenom, 100)),
+		},
+		{
+			name:          "mint missing coins into the escrow account",
+			msg:           types.NewMsgReconcileEscrow(authority, "channel-0", sdk.NewCoins(sdk.NewInt64Coin(testDenom, 50))),
+			expReconciled: nil,
+			expEscrow:     sdk.NewCoins(sdk.NewInt64Coin(testDenom, 150)),
+			expTotal:      sdk.NewCoins(sdk.NewInt64Coin(testDenom, 150)),
+		},
+		{
+			name:          "mint more than missing",
+			msg:           types.NewMsgReconcileEscrow(authority, "channel-0", sdk.NewCoins(sdk.NewInt64Coin(testDenom, 60))),
+			expReconciled: []types.DenomEscrow{{Denom: testDenom, Escrowed: sdk.NewInt(160), TotalEscrow: sdk.NewInt(150)}},
+			expEscrow:     sdk.NewCoins(sdk.NewInt64Coin(testDenom, 160)),
+			expTotal:      sdk.NewCoins(sdk.NewInt64Coin(testDenom, 160)),
+		},
+		{
+			name:   "unknown channel",
+			msg:    types.NewMsgReconcileEscrow(authority, "channel-9", sdk.NewCoins(sdk.NewInt64Coin(testDenom, 50))),
+			expErr: gaiaerrors.ErrNotFound,
+		},
+		{
+			name:   "invalid authority",
+			msg:    types.NewMsgReconcileEscrow(sdk.AccAddress("unauthorized").String(), "", nil),
+			expErr: gaiaerrors.ErrUnauthorized,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			gaiaApp := helpers.Setup(t)
+			ctx := gaiaApp.NewUncachedContext(true, tmproto.Header{})
+
+			setupChannels(t, gaiaApp, ctx, map[string]sdk.Coins{
+				"channel-0": sdk.NewCoins(sdk.NewInt64Coin(testDenom, 100)),
+			})
+			// the transfer module recorded 50 more than what is escrowed
+			gaiaApp.TransferKeeper.SetTotalEscrowForDenom(ctx, sdk.NewInt64Coin(testDenom, 150))
+
+			require.NoError(t, tc.msg.ValidateBasic())
+			res, err := keeper.NewMsgServerImpl(gaiaApp.EscrowKeeper).ReconcileEscrow(sdk.WrapSDKContext(ctx), tc.msg)
+			if tc.expErr != nil {
+				require.ErrorIs(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expReconciled, res.Reconciled)
+
+			escrowAddress := transfertypes.GetEscrowAddress(transfertypes.PortID, "channel-0")
+			require.Equal(t, tc.expEscrow, gaiaApp.BankKeeper.GetAllBalances(ctx, escrowAddress))
+			require.Equal(t, tc.expTotal, gaiaApp.TransferKeeper.GetAllTotalEscrowed(ctx))
+
+			_, broken := keeper.EscrowDeficitInvariant(gaiaApp.EscrowKeeper)(ctx)
+			require.False(t, broken)
+		})
+	}
+}
+
+func TestMsgReconcileEscrowValidateBasic(t *testing.T) {
+	authority := sdk.AccAddress("authority").String()
+
+	require.NoError(t, types.NewMsgReconcileEscrow(authority, "", nil).ValidateBasic())
+	require.NoError(t, types.NewMsgReconcileEscrow(authority, "channel-0", sdk.NewCoins(sdk.NewInt64Coin(testDenom, 1))).ValidateBasic())
+	require.Error(t, types.NewMsgReconcileEscrow("", "", nil).ValidateBasic())
+	require.Error(t, types.NewMsgReconcileEscrow(authority, "", sdk.NewCoins(sdk.NewInt64Coin(testDenom, 1))).ValidateBasic())
+	require.Error(t, types.NewMsgReconcileEscrow(authority, "channel-0", sdk.Coins{sdk.Coin{Denom: testDenom, Amount: sdk.NewInt(-1)}}).ValidateBasic())
+}
@@ -0,0 +1,68 @@
+package keeper
+
+import (
+	"context"
+
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	gaiaerrors "github.com/cosmos/gaia/v17/types/errors"
+	"github.com/cosmos/gaia/v17/x/escrow/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the x/escrow MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+var _ types.MsgServer = msgServer{}
+
+// ReconcileEscrow mints the requested coins into the escrow account of the
+// channel and then sets the recorded total escrow of every drifted denom to
+// the amount held by the escrow accounts.
+func (k msgServer) ReconcileEscrow(goCtx context.Context, msg *types.MsgReconcileEscrow) (*types.MsgReconcileEscrowResponse, error) {
+	if k.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(gaiaerrors.ErrUnauthorized, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !msg.Mint.Empty() {
+		portID := k.transferKeeper.GetPort(ctx)
+		if _, found := k.channelKeeper.GetChannel(ctx, portID, msg.ChannelId); !found {
+			return nil, errorsmod.Wrapf(gaiaerrors.ErrNotFound, "channel %s on port %s", msg.ChannelId, portID)
+		}
+
+		escrowAddress := transfertypes.GetEscrowAddress(portID, msg.ChannelId)
+		if err := k.bankKeeper.MintCoins(ctx, transfertypes.ModuleName, msg.Mint); err != nil {
+			return nil, err
+		}
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, transfertypes.ModuleName, escrowAddress, msg.Mint); err != nil {
+			return nil, err
+		}
+
+		k.Logger(ctx).Info("minted coins into escrow account", "channel", msg.ChannelId, "escrow", escrowAddress.String(), "coins", msg.Mint.String())
+	}
+
+	var reconciled []types.DenomEscrow
+	for _, escrow := range k.GetDenomEscrows(ctx, k.GetChannelEscrows(ctx)) {
+		if escrow.InSync() {
+			continue
+		}
+
+		k.transferKeeper.SetTotalEscrowForDenom(ctx, sdk.NewCoin(escrow.Denom, escrow.Escrowed))
+		reconciled = append(reconciled, escrow)
+
+		k.Logger(ctx).Info("reconciled total escrow", "denom", escrow.Denom, "from", escrow.TotalEscrow.String(), "to", escrow.Escrowed.String())
+	}
+
+	return &types.MsgReconcileEscrowResponse{Reconciled: reconciled}, nil
+}
@@ -0,0 +1,13 @@
+package types
+
+// InSync returns true if the escrowed amount matches the recorded total escrow.
+func (d DenomEscrow) InSync() bool {
+	return d.Escrowed.Equal(d.TotalEscrow)
+}
+
+// IsDeficit returns true if the escrow accounts hold less than the recorded
+// total escrow, i.e. the counterparty chains may hold vouchers that cannot be
+// redeemed.
+func (d DenomEscrow) IsDeficit() bool {
+	return d.Escrowed.LT(d.TotalEscrow)
+}
@@ -0,0 +1,769 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: gaia/escrow/v1beta1/escrow.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ChannelEscrow defines the balance of the escrow account of a transfer
+// channel.
+type ChannelEscrow struct {
+	PortId        string                                   `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	ChannelId     string                                   `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	EscrowAddress string                                   `protobuf:"bytes,3,opt,name=escrow_address,json=escrowAddress,proto3" json:"escrow_address,omitempty"`
+	Balance       github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=balance,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"balance"`
+}
+
+func (m *ChannelEscrow) Reset()         { *m = ChannelEscrow{} }
+func (m *ChannelEscrow) String() string { return proto.CompactTextString(m) }
+func (*ChannelEscrow) ProtoMessage()    {}
+func (*ChannelEscrow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b5d50c4d1a619280, []int{0}
+}
+func (m *ChannelEscrow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChannelEscrow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChannelEscrow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChannelEscrow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelEscrow.Merge(m, src)
+}
+func (m *ChannelEscrow) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChannelEscrow) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelEscrow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChannelEscrow proto.InternalMessageInfo
+
+func (m *ChannelEscrow) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *ChannelEscrow) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *ChannelEscrow) GetEscrowAddress() string {
+	if m != nil {
+		return m.EscrowAddress
+	}
+	return ""
+}
+
+func (m *ChannelEscrow) GetBalance() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Balance
+	}
+	return nil
+}
+
+// DenomEscrow compares, for a single denom, the amount held by all transfer
+// channel escrow accounts with the total escrow amount recorded by the
+// transfer module.
+type DenomEscrow struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// escrowed is the sum of the balances of all transfer channel escrow
+	// accounts.
+	Escrowed github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=escrowed,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"escrowed"`
+	// total_escrow is the total escrow amount recorded by the transfer module.
+	TotalEscrow github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=total_escrow,json=totalEscrow,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"total_escrow"`
+}
+
+func (m *DenomEscrow) Reset()         { *m = DenomEscrow{} }
+func (m *DenomEscrow) String() string { return proto.CompactTextString(m) }
+func (*DenomEscrow) ProtoMessage()    {}
+func (*DenomEscrow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b5d50c4d1a619280, []int{1}
+}
+func (m *DenomEscrow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomEscrow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomEscrow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomEscrow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomEscrow.Merge(m, src)
+}
+func (m *DenomEscrow) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomEscrow) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomEscrow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomEscrow proto.InternalMessageInfo
+
+func (m *DenomEscrow) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*ChannelEscrow)(nil), "gaia.escrow.v1beta1.ChannelEscrow")
+	proto.RegisterType((*DenomEscrow)(nil), "gaia.escrow.v1beta1.DenomEscrow")
+}
+
+func init() { proto.RegisterFile("gaia/escrow/v1beta1/escrow.proto", fileDescriptor_b5d50c4d1a619280) }
+
+var fileDescriptor_b5d50c4d1a619280 = []byte{
+	// 386 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x52, 0xcd, 0x4e, 0xea, 0x40,
+	0x18, 0x6d, 0x2f, 0xf7, 0xc2, 0x65, 0x10, 0x17, 0x95, 0xc4, 0x42, 0x62, 0x21, 0x24, 0x1a, 0x36,
+	0xb4, 0xa2, 0x5b, 0x13, 0x62, 0xd1, 0x45, 0xb7, 0x75, 0x63, 0xdc, 0x34, 0xd3, 0xce, 0xa4, 0x34,
+	0xc2, 0x0c, 0xe9, 0x8c, 0x7f, 0x6f, 0xe1, 0x73, 0xb8, 0xe6, 0x21, 0x58, 0x12, 0x56, 0xc6, 0x05,
+	0x1a, 0x78, 0x00, 0x5f, 0xc1, 0xcc, 0x0f, 0x6c, 0xdc, 0xb8, 0x70, 0x05, 0xe7, 0x3b, 0x67, 0xce,
+	0x77, 0x4e, 0xf3, 0x81, 0x56, 0x0a, 0x33, 0xe8, 0x61, 0x96, 0xe4, 0xf4, 0xc1, 0xbb, 0xef, 0xc5,
+	0x98, 0xc3, 0x9e, 0x86, 0xee, 0x24, 0xa7, 0x9c, 0x5a, 0x7b, 0x42, 0xe1, 0xea, 0x91, 0x56, 0x34,
+	0x6a, 0x29, 0x4d, 0xa9, 0xe4, 0x3d, 0xf1, 0x4f, 0x49, 0x1b, 0xf5, 0x84, 0xb2, 0x31, 0x65, 0x91,
+	0x22, 0x14, 0xd0, 0x94, 0xa3, 0x90, 0x17, 0x43, 0x86, 0xb7, 0x7b, 0x12, 0x9a, 0x11, 0xc5, 0xb7,
+	0x3f, 0x4d, 0x50, 0x1d, 0x0c, 0x21, 0x21, 0x78, 0x74, 0x29, 0x57, 0x59, 0xfb, 0xa0, 0x34, 0xa1,
+	0x39, 0x8f, 0x32, 0x64, 0x9b, 0x2d, 0xb3, 0x53, 0x0e, 0x8b, 0x02, 0x06, 0xc8, 0x3a, 0x00, 0x20,
+	0x51, 0x4a, 0xc1, 0xfd, 0x91, 0x5c, 0x59, 0x4f, 0x02, 0x64, 0xf5, 0xc1, 0xae, 0x0a, 0x1b, 0x41,
+	0x84, 0x72, 0xcc, 0x98, 0x5d, 0x10, 0x12, 0xdf, 0x5e, 0x4c, 0xbb, 0x35, 0x9d, 0xe9, 0x5c, 0x31,
+	0x57, 0x3c, 0xcf, 0x48, 0x1a, 0x56, 0x95, 0x5e, 0x0f, 0x2d, 0x0c, 0x4a, 0x31, 0x1c, 0x41, 0x92,
+	0x60, 0xfb, 0x6f, 0xab, 0xd0, 0xa9, 0x9c, 0xd4, 0x5d, 0xfd, 0x4c, 0x84, 0xdf, 0x7c, 0x02, 0x77,
+	0x40, 0x33, 0xe2, 0x1f, 0xcf, 0x96, 0x4d, 0xe3, 0xe5, 0xbd, 0xd9, 0x49, 0x33, 0x3e, 0xbc, 0x8b,
+	0xdd, 0x84, 0x8e, 0x75, 0x6f, 0xfd, 0xd3, 0x65, 0xe8, 0xd6, 0xe3, 0x4f, 0x13, 0xcc, 0xe4, 0x03,
+	0x16, 0x6e, 0xbc, 0xdb, 0x4b, 0x13, 0x54, 0x2e, 0x30, 0xa1, 0x63, 0xdd, 0xb7, 0x06, 0xfe, 0x21,
+	0x01, 0x75, 0x5b, 0x05, 0xac, 0x6b, 0xf0, 0x5f, 0xa5, 0xc3, 0xba, 0xaa, 0x7f, 0x26, 0x56, 0xbe,
+	0x2d, 0x9b, 0x47, 0x3f, 0x58, 0x19, 0x10, 0xbe, 0x98, 0x76, 0x81, 0x8e, 0x1f, 0x10, 0x1e, 0x6e,
+	0xdd, 0xac, 0x08, 0xec, 0x70, 0xca, 0xe1, 0x28, 0x52, 0x13, 0xbb, 0xf0, 0x0b, 0xee, 0x15, 0xe9,
+	0xa8, 0x0a, 0xf9, 0xfd, 0xd9, 0xca, 0x31, 0xe7, 0x2b, 0xc7, 0xfc, 0x58, 0x39, 0xe6, 0xf3, 0xda,
+	0x31, 0xe6, 0x6b, 0xc7, 0x78, 0x5d, 0x3b, 0xc6, 0xcd, 0xe1, 0x77, 0x73, 0x79, 0x86, 0x8f, 0x9b,
+	0x43, 0x94, 0xfe, 0x71, 0x51, 0x9e, 0xc6, 0xe9, 0xd7, 0x00, 0x41, 0x7c, 0x48, 0x45, 0xa4, 0x02,
+	0x00, 0x00,
+}
+
+func (m *ChannelEscrow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChannelEscrow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChannelEscrow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Balance) > 0 {
+		for iNdEx := len(m.Balance) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Balance[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEscrow(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.EscrowAddress) > 0 {
+		i -= len(m.EscrowAddress)
+		copy(dAtA[i:], m.EscrowAddress)
+		i = encodeVarintEscrow(dAtA, i, uint64(len(m.EscrowAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintEscrow(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintEscrow(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DenomEscrow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomEscrow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomEscrow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TotalEscrow.Size()
+		i -= size
+		if _, err := m.TotalEscrow.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEscrow(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Escrowed.Size()
+		i -= size
+		if _, err := m.Escrowed.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEscrow(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintEscrow(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEscrow(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEscrow(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ChannelEscrow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovEscrow(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovEscrow(uint64(l))
+	}
+	l = len(m.EscrowAddress)
+	if l > 0 {
+		n += 1 + l + sovEscrow(uint64(l))
+	}
+	if len(m.Balance) > 0 {
+		for _, e := range m.Balance {
+			l = e.Size()
+			n += 1 + l + sovEscrow(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DenomEscrow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovEscrow(uint64(l))
+	}
+	l = m.Escrowed.Size()
+	n += 1 + l + sovEscrow(uint64(l))
+	l = m.TotalEscrow.Size()
+	n += 1 + l + sovEscrow(uint64(l))
+	return n
+}
+
+func sovEscrow(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEscrow(x uint64) (n int) {
+	return sovEscrow(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ChannelEscrow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEscrow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChannelEscrow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChannelEscrow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEscrow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEscrow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEscrow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEscrow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEscrow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEscrow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEscrow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEscrow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEscrow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EscrowAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEscrow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEscrow
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEscrow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Balance = append(m.Balance, types.Coin{})
+			if err := m.Balance[len(m.Balance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEscrow(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEscrow
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DenomEscrow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEscrow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomEscrow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomEscrow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEscrow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEscrow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEscrow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Escrowed", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEscrow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEscrow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEscrow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Escrowed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalEscrow", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEscrow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEscrow
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEscrow
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalEscrow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEscrow(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEscrow
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipEscrow(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowEscrow
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEscrow
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEscrow
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthEscrow
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupEscrow
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthEscrow
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthEscrow        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowEscrow          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupEscrow = fmt.Errorf("proto: unexpected end of group")
+)
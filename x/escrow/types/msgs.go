@@ -0,0 +1,48 @@
+package types
+
+import (
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	gaiaerrors "github.com/cosmos/gaia/v17/types/errors"
+)
+
+var _ sdk.Msg = &MsgReconcileEscrow{}
+
+// NewMsgReconcileEscrow creates a new MsgReconcileEscrow instance
+func NewMsgReconcileEscrow(authority, channelID string, mint sdk.Coins) *MsgReconcileEscrow {
+	return &MsgReconcileEscrow{
+		Authority: authority,
+		ChannelId: channelID,
+		Mint:      mint,
+	}
+}
+
+// GetSigners implements sdk.Msg
+func (m MsgReconcileEscrow) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(m.Authority)}
+}
+
+// ValidateBasic implements sdk.Msg
+func (m MsgReconcileEscrow) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "authority")
+	}
+
+	if !m.Mint.IsValid() {
+		return errorsmod.Wrap(gaiaerrors.ErrInvalidCoins, m.Mint.String())
+	}
+
+	if m.Mint.Empty() {
+		return nil
+	}
+
+	if err := host.ChannelIdentifierValidator(m.ChannelId); err != nil {
+		return errorsmod.Wrap(err, "channel id is required to mint into its escrow account")
+	}
+
+	return nil
+}
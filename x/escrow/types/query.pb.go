@@ -0,0 +1,655 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: gaia/escrow/v1beta1/query.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// QueryEscrowsRequest is the request type for the Query/Escrows RPC method.
+type QueryEscrowsRequest struct {
+	// drift_only limits the denoms to those whose escrowed amount differs from
+	// the recorded total escrow.
+	DriftOnly bool `protobuf:"varint,1,opt,name=drift_only,json=driftOnly,proto3" json:"drift_only,omitempty"`
+}
+
+func (m *QueryEscrowsRequest) Reset()         { *m = QueryEscrowsRequest{} }
+func (m *QueryEscrowsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEscrowsRequest) ProtoMessage()    {}
+func (*QueryEscrowsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b4ec6796be5b2919, []int{0}
+}
+func (m *QueryEscrowsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEscrowsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEscrowsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEscrowsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEscrowsRequest.Merge(m, src)
+}
+func (m *QueryEscrowsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEscrowsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEscrowsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEscrowsRequest proto.InternalMessageInfo
+
+func (m *QueryEscrowsRequest) GetDriftOnly() bool {
+	if m != nil {
+		return m.DriftOnly
+	}
+	return false
+}
+
+// QueryEscrowsResponse is the response type for the Query/Escrows RPC method.
+type QueryEscrowsResponse struct {
+	Channels []ChannelEscrow `protobuf:"bytes,1,rep,name=channels,proto3" json:"channels"`
+	Denoms   []DenomEscrow   `protobuf:"bytes,2,rep,name=denoms,proto3" json:"denoms"`
+}
+
+func (m *QueryEscrowsResponse) Reset()         { *m = QueryEscrowsResponse{} }
+func (m *QueryEscrowsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEscrowsResponse) ProtoMessage()    {}
+func (*QueryEscrowsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b4ec6796be5b2919, []int{1}
+}
+func (m *QueryEscrowsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEscrowsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEscrowsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEscrowsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEscrowsResponse.Merge(m, src)
+}
+func (m *QueryEscrowsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEscrowsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEscrowsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEscrowsResponse proto.InternalMessageInfo
+
+func (m *QueryEscrowsResponse) GetChannels() []ChannelEscrow {
+	if m != nil {
+		return m.Channels
+	}
+	return nil
+}
+
+func (m *QueryEscrowsResponse) GetDenoms() []DenomEscrow {
+	if m != nil {
+		return m.Denoms
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryEscrowsRequest)(nil), "gaia.escrow.v1beta1.QueryEscrowsRequest")
+	proto.RegisterType((*QueryEscrowsResponse)(nil), "gaia.escrow.v1beta1.QueryEscrowsResponse")
+}
+
+func init() { proto.RegisterFile("gaia/escrow/v1beta1/query.proto", fileDescriptor_b4ec6796be5b2919) }
+
+var fileDescriptor_b4ec6796be5b2919 = []byte{
+	// 332 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x91, 0x31, 0x4b, 0xc3, 0x40,
+	0x14, 0xc7, 0x73, 0x55, 0x6b, 0x3d, 0xb7, 0x6b, 0x87, 0x52, 0xea, 0x35, 0x04, 0x85, 0xba, 0xe4,
+	0x68, 0x75, 0x56, 0xa8, 0x75, 0x16, 0x3b, 0xba, 0xc8, 0x35, 0x3d, 0xd3, 0x40, 0x7a, 0x2f, 0xcd,
+	0x5d, 0xd5, 0xac, 0xe2, 0x2c, 0x82, 0xab, 0x1f, 0xa8, 0x63, 0xc1, 0xc5, 0x49, 0xa4, 0xf5, 0x83,
+	0x48, 0x2e, 0x51, 0x28, 0x44, 0x70, 0x0b, 0xef, 0xfd, 0xfe, 0xbf, 0xf7, 0x5e, 0x0e, 0xb7, 0x7c,
+	0x1e, 0x70, 0x26, 0x94, 0x17, 0xc3, 0x1d, 0xbb, 0xed, 0x0c, 0x85, 0xe6, 0x1d, 0x36, 0x9d, 0x89,
+	0x38, 0x71, 0xa3, 0x18, 0x34, 0x90, 0x6a, 0x0a, 0xb8, 0x19, 0xe0, 0xe6, 0x40, 0xa3, 0xe6, 0x83,
+	0x0f, 0xa6, 0xcf, 0xd2, 0xaf, 0x0c, 0x6d, 0x34, 0x7d, 0x00, 0x3f, 0x14, 0x8c, 0x47, 0x01, 0xe3,
+	0x52, 0x82, 0xe6, 0x3a, 0x00, 0xa9, 0xf2, 0xae, 0x5d, 0x34, 0x29, 0xf7, 0x1a, 0xc2, 0x39, 0xc6,
+	0xd5, 0xcb, 0x74, 0xf2, 0xb9, 0x29, 0xaa, 0x81, 0x98, 0xce, 0x84, 0xd2, 0x64, 0x0f, 0xe3, 0x51,
+	0x1c, 0xdc, 0xe8, 0x6b, 0x90, 0x61, 0x52, 0x47, 0x36, 0x6a, 0x57, 0x06, 0x3b, 0xa6, 0x72, 0x21,
+	0xc3, 0xc4, 0x79, 0x45, 0xb8, 0xb6, 0x1e, 0x53, 0x11, 0x48, 0x25, 0x48, 0x1f, 0x57, 0xbc, 0x31,
+	0x97, 0x52, 0x84, 0xaa, 0x8e, 0xec, 0x8d, 0xf6, 0x6e, 0xd7, 0x71, 0x0b, 0x8e, 0x71, 0xcf, 0x32,
+	0x28, 0x8b, 0xf7, 0x36, 0xe7, 0x1f, 0x2d, 0x6b, 0xf0, 0x9b, 0x24, 0x27, 0xb8, 0x3c, 0x12, 0x12,
+	0x26, 0xaa, 0x5e, 0x32, 0x0e, 0xbb, 0xd0, 0xd1, 0x4f, 0x91, 0x35, 0x43, 0x9e, 0xea, 0x3e, 0x21,
+	0xbc, 0x65, 0xd6, 0x23, 0x8f, 0x08, 0x6f, 0xe7, 0x3b, 0x92, 0x76, 0xa1, 0xa5, 0xe0, 0xfa, 0xc6,
+	0xe1, 0x3f, 0xc8, 0xec, 0x60, 0x67, 0xff, 0xe1, 0xed, 0xeb, 0xa5, 0x44, 0x49, 0x93, 0xfd, 0xfd,
+	0xab, 0x55, 0xef, 0x74, 0xbe, 0xa4, 0x68, 0xb1, 0xa4, 0xe8, 0x73, 0x49, 0xd1, 0xf3, 0x8a, 0x5a,
+	0x8b, 0x15, 0xb5, 0xde, 0x57, 0xd4, 0xba, 0x3a, 0xf0, 0x03, 0x3d, 0x9e, 0x0d, 0x5d, 0x0f, 0x26,
+	0xcc, 0x03, 0x35, 0x01, 0x95, 0x89, 0xee, 0x7f, 0x54, 0x3a, 0x89, 0x84, 0x1a, 0x96, 0xcd, 0x6b,
+	0x1d, 0x7d, 0x0f, 0x00, 0xda, 0x78, 0xc9, 0xf1, 0x3b, 0x02, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Escrows returns the escrow account balance of every transfer channel and
+	// compares, per denom, the escrowed amounts with the total escrow recorded
+	// by the transfer module.
+	Escrows(ctx context.Context, in *QueryEscrowsRequest, opts ...grpc.CallOption) (*QueryEscrowsResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Escrows(ctx context.Context, in *QueryEscrowsRequest, opts ...grpc.CallOption) (*QueryEscrowsResponse, error) {
+	out := new(QueryEscrowsResponse)
+	err := c.cc.Invoke(ctx, "/gaia.escrow.v1beta1.Query/Escrows", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Escrows returns the escrow account balance of every transfer channel and
+	// compares, per denom, the escrowed amounts with the total escrow recorded
+	// by the transfer module.
+	Escrows(context.Context, *QueryEscrowsRequest) (*QueryEscrowsResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Escrows(ctx context.Context, req *QueryEscrowsRequest) (*QueryEscrowsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Escrows not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Escrows_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEscrowsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Escrows(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gaia.escrow.v1beta1.Query/Escrows",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Escrows(ctx, req.(*QueryEscrowsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "gaia.escrow.v1beta1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Escrows",
+			Handler:    _Query_Escrows_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "gaia/escrow/v1beta1/query.proto",
+}
+
+func (m *QueryEscrowsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEscrowsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEscrowsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DriftOnly {
+		i--
+		if m.DriftOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEscrowsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEscrowsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEscrowsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denoms) > 0 {
+		for iNdEx := len(m.Denoms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Denoms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Channels) > 0 {
+		for iNdEx := len(m.Channels) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Channels[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryEscrowsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DriftOnly {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryEscrowsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Channels) > 0 {
+		for _, e := range m.Channels {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Denoms) > 0 {
+		for _, e := range m.Denoms {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryEscrowsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEscrowsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEscrowsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DriftOnly", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DriftOnly = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEscrowsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEscrowsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEscrowsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Channels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Channels = append(m.Channels, ChannelEscrow{})
+			if err := m.Channels[len(m.Channels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denoms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denoms = append(m.Denoms, DenomEscrow{})
+			if err := m.Denoms[len(m.Denoms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,736 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: gaia/escrow/v1beta1/tx.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MsgReconcileEscrow first mints the given coins into the escrow account of
+// the given transfer channel, e.g. to restore funds that are missing from an
+// escrow account, and then sets the total escrow recorded by the transfer
+// module to the amount actually held by the escrow accounts for every denom
+// that drifted.
+type MsgReconcileEscrow struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// channel_id is the transfer channel whose escrow account receives the
+	// minted coins. Required only if mint is not empty.
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// mint are the coins minted into the escrow account of the channel.
+	Mint github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=mint,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"mint"`
+}
+
+func (m *MsgReconcileEscrow) Reset()         { *m = MsgReconcileEscrow{} }
+func (m *MsgReconcileEscrow) String() string { return proto.CompactTextString(m) }
+func (*MsgReconcileEscrow) ProtoMessage()    {}
+func (*MsgReconcileEscrow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b5d9d24687cb614, []int{0}
+}
+func (m *MsgReconcileEscrow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgReconcileEscrow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReconcileEscrow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgReconcileEscrow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReconcileEscrow.Merge(m, src)
+}
+func (m *MsgReconcileEscrow) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReconcileEscrow) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReconcileEscrow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgReconcileEscrow proto.InternalMessageInfo
+
+func (m *MsgReconcileEscrow) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgReconcileEscrow) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *MsgReconcileEscrow) GetMint() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Mint
+	}
+	return nil
+}
+
+// MsgReconcileEscrowResponse defines the response structure for executing a
+// MsgReconcileEscrow message.
+type MsgReconcileEscrowResponse struct {
+	// reconciled are the denoms whose recorded total escrow was updated, with
+	// the total escrow before the update.
+	Reconciled []DenomEscrow `protobuf:"bytes,1,rep,name=reconciled,proto3" json:"reconciled"`
+}
+
+func (m *MsgReconcileEscrowResponse) Reset()         { *m = MsgReconcileEscrowResponse{} }
+func (m *MsgReconcileEscrowResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgReconcileEscrowResponse) ProtoMessage()    {}
+func (*MsgReconcileEscrowResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7b5d9d24687cb614, []int{1}
+}
+func (m *MsgReconcileEscrowResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgReconcileEscrowResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReconcileEscrowResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgReconcileEscrowResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReconcileEscrowResponse.Merge(m, src)
+}
+func (m *MsgReconcileEscrowResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReconcileEscrowResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReconcileEscrowResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgReconcileEscrowResponse proto.InternalMessageInfo
+
+func (m *MsgReconcileEscrowResponse) GetReconciled() []DenomEscrow {
+	if m != nil {
+		return m.Reconciled
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*MsgReconcileEscrow)(nil), "gaia.escrow.v1beta1.MsgReconcileEscrow")
+	proto.RegisterType((*MsgReconcileEscrowResponse)(nil), "gaia.escrow.v1beta1.MsgReconcileEscrowResponse")
+}
+
+func init() { proto.RegisterFile("gaia/escrow/v1beta1/tx.proto", fileDescriptor_7b5d9d24687cb614) }
+
+var fileDescriptor_7b5d9d24687cb614 = []byte{
+	// 434 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xbf, 0xae, 0xd3, 0x30,
+	0x14, 0xc6, 0x93, 0xdb, 0x0b, 0x52, 0xcd, 0x80, 0x08, 0x57, 0x22, 0x37, 0x82, 0xdc, 0xe8, 0x4a,
+	0x88, 0xaa, 0x52, 0x6d, 0xb5, 0x15, 0x0c, 0x2c, 0x88, 0xf0, 0x47, 0x62, 0xe8, 0x12, 0x36, 0x96,
+	0x2a, 0x89, 0x2d, 0xd7, 0x6a, 0x63, 0x57, 0xb1, 0x5b, 0xda, 0x0d, 0x31, 0x30, 0x30, 0xf1, 0x18,
+	0x88, 0xa9, 0x03, 0x0f, 0xd1, 0xb1, 0x62, 0x62, 0x02, 0xd4, 0x0e, 0x7d, 0x0d, 0x14, 0xc7, 0xa1,
+	0x40, 0x3a, 0xdc, 0x25, 0xce, 0x39, 0xbf, 0x73, 0x4e, 0xbe, 0xf3, 0xc5, 0xe0, 0x2e, 0x8d, 0x59,
+	0x8c, 0x88, 0x4c, 0x73, 0xf1, 0x16, 0xcd, 0xbb, 0x09, 0x51, 0x71, 0x17, 0xa9, 0x05, 0x9c, 0xe6,
+	0x42, 0x09, 0xe7, 0x76, 0x41, 0x61, 0x49, 0xa1, 0xa1, 0xde, 0x19, 0x15, 0x54, 0x68, 0x8e, 0x8a,
+	0xb7, 0xb2, 0xd4, 0x3b, 0x4f, 0x85, 0xcc, 0x84, 0x1c, 0x96, 0xa0, 0x0c, 0x0c, 0xf2, 0xcb, 0x08,
+	0x25, 0xb1, 0x24, 0x7f, 0xbe, 0x91, 0x0a, 0xc6, 0x0d, 0xbf, 0x63, 0x78, 0x26, 0x29, 0x9a, 0x77,
+	0x8b, 0xc3, 0x80, 0x5b, 0x71, 0xc6, 0xb8, 0x40, 0xfa, 0x69, 0x52, 0xc1, 0x31, 0xbd, 0x46, 0xa0,
+	0xae, 0xb8, 0xfc, 0x70, 0x02, 0x9c, 0x81, 0xa4, 0x11, 0x49, 0x05, 0x4f, 0xd9, 0x84, 0xbc, 0xd0,
+	0xd0, 0x79, 0x04, 0x9a, 0xf1, 0x4c, 0x8d, 0x44, 0xce, 0xd4, 0xd2, 0xb5, 0x03, 0xbb, 0xd5, 0x0c,
+	0xdd, 0x6f, 0x5f, 0x3b, 0x67, 0x46, 0xe9, 0x53, 0x8c, 0x73, 0x22, 0xe5, 0x6b, 0x95, 0x33, 0x4e,
+	0xa3, 0x43, 0xa9, 0x73, 0x0f, 0x80, 0x74, 0x14, 0x73, 0x4e, 0x26, 0x43, 0x86, 0xdd, 0x93, 0xa2,
+	0x31, 0x6a, 0x9a, 0xcc, 0x2b, 0xec, 0x60, 0x70, 0x9a, 0x31, 0xae, 0xdc, 0x46, 0xd0, 0x68, 0xdd,
+	0xe8, 0x9d, 0x43, 0x33, 0xae, 0x58, 0xb5, 0x32, 0x0c, 0x3e, 0x13, 0x8c, 0x87, 0x0f, 0xd7, 0x3f,
+	0x2e, 0xac, 0x2f, 0x3f, 0x2f, 0x5a, 0x94, 0xa9, 0xd1, 0x2c, 0x81, 0xa9, 0xc8, 0x8c, 0x4b, 0xe6,
+	0xe8, 0x48, 0x3c, 0x46, 0x6a, 0x39, 0x25, 0x52, 0x37, 0xc8, 0xcf, 0xfb, 0x55, 0xdb, 0x8e, 0xf4,
+	0xf4, 0xc7, 0xfd, 0xf7, 0xfb, 0x55, 0xfb, 0x20, 0xea, 0xe3, 0x7e, 0xd5, 0x2e, 0x8d, 0x58, 0x54,
+	0x56, 0xd4, 0x37, 0xbe, 0xc4, 0xc0, 0xab, 0x67, 0x23, 0x22, 0xa7, 0x82, 0x4b, 0xe2, 0xbc, 0x04,
+	0x20, 0xaf, 0x10, 0x76, 0x6d, 0x2d, 0x3f, 0x80, 0x47, 0xfe, 0x37, 0x7c, 0x4e, 0xb8, 0xc8, 0xca,
+	0xee, 0xf0, 0xb4, 0xd8, 0x22, 0xfa, 0xab, 0xb3, 0xb7, 0x04, 0x8d, 0x81, 0xa4, 0xce, 0x18, 0xdc,
+	0xfc, 0xdf, 0xf1, 0x07, 0x47, 0xa7, 0xd5, 0x25, 0x79, 0xe8, 0x8a, 0x85, 0x95, 0x76, 0xef, 0xda,
+	0xbb, 0xc2, 0x9b, 0xf0, 0xc9, 0x7a, 0xeb, 0xdb, 0x9b, 0xad, 0x6f, 0xff, 0xda, 0xfa, 0xf6, 0xa7,
+	0x9d, 0x6f, 0x6d, 0x76, 0xbe, 0xf5, 0x7d, 0xe7, 0x5b, 0x6f, 0xee, 0xd7, 0x4d, 0xfe, 0xd7, 0x2e,
+	0xed, 0x73, 0x72, 0x5d, 0xdf, 0x98, 0xfe, 0xef, 0x01, 0x00, 0xfa, 0x1f, 0x8c, 0xdb, 0x05, 0x03,
+	0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// ReconcileEscrow is a governance operation that fixes a drift between the
+	// transfer channel escrow accounts and the total escrow recorded by the
+	// transfer module.
+	ReconcileEscrow(ctx context.Context, in *MsgReconcileEscrow, opts ...grpc.CallOption) (*MsgReconcileEscrowResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) ReconcileEscrow(ctx context.Context, in *MsgReconcileEscrow, opts ...grpc.CallOption) (*MsgReconcileEscrowResponse, error) {
+	out := new(MsgReconcileEscrowResponse)
+	err := c.cc.Invoke(ctx, "/gaia.escrow.v1beta1.Msg/ReconcileEscrow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// ReconcileEscrow is a governance operation that fixes a drift between the
+	// transfer channel escrow accounts and the total escrow recorded by the
+	// transfer module.
+	ReconcileEscrow(context.Context, *MsgReconcileEscrow) (*MsgReconcileEscrowResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) ReconcileEscrow(ctx context.Context, req *MsgReconcileEscrow) (*MsgReconcileEscrowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReconcileEscrow not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_ReconcileEscrow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgReconcileEscrow)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ReconcileEscrow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gaia.escrow.v1beta1.Msg/ReconcileEscrow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ReconcileEscrow(ctx, req.(*MsgReconcileEscrow))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "gaia.escrow.v1beta1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ReconcileEscrow",
+			Handler:    _Msg_ReconcileEscrow_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "gaia/escrow/v1beta1/tx.proto",
+}
+
+func (m *MsgReconcileEscrow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReconcileEscrow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReconcileEscrow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Mint) > 0 {
+		for iNdEx := len(m.Mint) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Mint[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgReconcileEscrowResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReconcileEscrowResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReconcileEscrowResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reconciled) > 0 {
+		for iNdEx := len(m.Reconciled) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Reconciled[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgReconcileEscrow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Mint) > 0 {
+		for _, e := range m.Mint {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgReconcileEscrowResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Reconciled) > 0 {
+		for _, e := range m.Reconciled {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgReconcileEscrow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgReconcileEscrow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgReconcileEscrow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mint", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Mint = append(m.Mint, types.Coin{})
+			if err := m.Mint[len(m.Mint)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgReconcileEscrowResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgReconcileEscrowResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgReconcileEscrowResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reconciled", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reconciled = append(m.Reconciled, DenomEscrow{})
+			if err := m.Reconciled[len(m.Reconciled)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTx(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTx
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTx
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTx
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTx        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTx          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTx = fmt.Errorf("proto: unexpected end of group")
+)
@@ -2,7 +2,9 @@ package antetest
 
 import (
 	"testing"
+	"time"
 
+	"github.com/armon/go-metrics"
 	"github.com/stretchr/testify/suite"
 
 	ibcclienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
@@ -795,4 +797,78 @@ func (s *IntegrationTestSuite) TestGetTxFeeRequired() {
 	res, err = feeDecorator.GetTxFeeRequired(ctx, tx)
 	s.Require().NoError(err)
 	s.Require().True(res.IsEqual(globalFee))
+
+	// check that the global fee is returned in CheckTx mode for the local
+	// bypass msg types
+	localBypassDecorator := feeDecorator.WithLocalBypassMinFeeMsgTypes([]string{sdk.MsgTypeURL(&testdata.TestMsg{})})
+	res, err = localBypassDecorator.GetTxFeeRequired(s.ctx, tx)
+	s.Require().NoError(err)
+	s.Require().True(res.IsEqual(globalFee))
+	s.Require().Empty(feeDecorator.LocalBypassMinFeeMsgTypes)
+}
+
+func (s *IntegrationTestSuite) TestFeeMetrics() {
+	sink := metrics.NewInmemSink(time.Hour, time.Hour)
+	cfg := metrics.DefaultConfig("gaia")
+	cfg.EnableHostname = false
+	cfg.EnableRuntimeMetrics = false
+	_, err := metrics.NewGlobal(cfg, sink)
+	s.Require().NoError(err)
+	defer func() {
+		_, _ = metrics.NewGlobal(cfg, &metrics.BlackholeSink{})
+	}()
+
+	_, antehandler := s.SetupTestGlobalFeeStoreAndMinGasPrice(
+		[]sdk.DecCoin{sdk.NewDecCoinFromDec("uatom", sdk.NewDecWithPrec(1, 3))},
+		&globfeetypes.Params{
+			MinimumGasPrices:                []sdk.DecCoin{},
+			BypassMinFeeMsgTypes:            []string{sdk.MsgTypeURL(&ibcchanneltypes.MsgRecvPacket{})},
+			MaxTotalBypassMinFeeMsgGasUsage: testGasLimit,
+		},
+	)
+	priv1, _, addr1 := testdata.KeyTestPubAddr()
+	privs, accNums, accSeqs := []cryptotypes.PrivKey{priv1}, []uint64{0}, []uint64{0}
+
+	for _, tc := range []struct {
+		msg    sdk.Msg
+		fee    sdk.Coins
+		gas    uint64
+		expErr bool
+	}{
+		// paid fees
+		{testdata.NewTestMsg(addr1), sdk.NewCoins(sdk.NewInt64Coin("uatom", 400)), 200_000, false},
+		// insufficient fees
+		{testdata.NewTestMsg(addr1), sdk.NewCoins(sdk.NewInt64Coin("uatom", 100)), 200_000, true},
+		// invalid denoms
+		{testdata.NewTestMsg(addr1), sdk.NewCoins(sdk.NewInt64Coin("photon", 400)), 200_000, true},
+		// bypass
+		{ibcchanneltypes.NewMsgRecvPacket(ibcchanneltypes.Packet{}, nil, ibcclienttypes.ZeroHeight(), ""), sdk.Coins{}, 100_000, false},
+		// bypass gas exceeded
+		{ibcchanneltypes.NewMsgRecvPacket(ibcchanneltypes.Packet{}, nil, ibcclienttypes.ZeroHeight(), ""), sdk.Coins{}, 300_000, true},
+	} {
+		s.txBuilder = s.clientCtx.TxConfig.NewTxBuilder()
+		s.Require().NoError(s.txBuilder.SetMsgs(tc.msg))
+		s.txBuilder.SetFeeAmount(tc.fee)
+		s.txBuilder.SetGasLimit(tc.gas)
+		tx, err := s.CreateTestTx(privs, accNums, accSeqs, s.ctx.ChainID())
+		s.Require().NoError(err)
+
+		_, err = antehandler(s.ctx, tx, false)
+		s.Require().Equal(tc.expErr, err != nil, err)
+	}
+
+	data := sink.Data()
+	s.Require().Len(data, 1)
+	counters, samples := data[0].Counters, data[0].Samples
+	for _, reason := range []string{
+		gaiafeeante.FeeRejectionInsufficientFees,
+		gaiafeeante.FeeRejectionInvalidDenoms,
+		gaiafeeante.FeeRejectionBypassGasExceeded,
+	} {
+		s.Require().Equal(1, counters["gaia.globalfee.rejected_txs;mode=check;reason="+reason].Count, reason)
+	}
+	s.Require().Equal(1, counters["gaia.globalfee.bypass_txs;mode=check"].Count)
+	s.Require().Equal(float64(100_000), samples["gaia.globalfee.bypass_gas;mode=check"].Sum)
+	s.Require().Equal(1, samples["gaia.globalfee.gas_price;mode=check;denom=uatom"].Count)
+	s.Require().InDelta(0.002, samples["gaia.globalfee.gas_price;mode=check;denom=uatom"].Sum, 1e-6)
 }
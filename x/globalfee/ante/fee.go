@@ -30,6 +30,9 @@ var _ sdk.AnteDecorator = FeeDecorator{}
 type FeeDecorator struct {
 	GlobalMinFeeParamSource globalfee.ParamSource
 	StakingKeeper           *stakingkeeper.Keeper
+	// LocalBypassMinFeeMsgTypes are the msg types of the txs that only pay
+	// the global fees in CheckTx, set by the node.
+	LocalBypassMinFeeMsgTypes []string
 }
 
 func NewFeeDecorator(globalfeeSubspace paramtypes.Subspace, sk *stakingkeeper.Keeper) FeeDecorator {
@@ -43,6 +46,14 @@ func NewFeeDecorator(globalfeeSubspace paramtypes.Subspace, sk *stakingkeeper.Ke
 	}
 }
 
+// WithLocalBypassMinFeeMsgTypes returns a copy of the decorator where the txs
+// made of the given msg types only pay the global fees in CheckTx, and not the
+// local minimum gas prices.
+func (mfd FeeDecorator) WithLocalBypassMinFeeMsgTypes(msgTypes []string) FeeDecorator {
+	mfd.LocalBypassMinFeeMsgTypes = msgTypes
+	return mfd
+}
+
 // AnteHandle implements the AnteDecorator interface
 func (mfd FeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
 	feeTx, ok := tx.(sdk.FeeTx)
@@ -65,6 +76,7 @@ func (mfd FeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 
 	// feeRequired cannot be empty
 	if feeTx.GetFee().Len() > feeRequired.Len() {
+		RecordFeeRejection(ctx, FeeRejectionInvalidDenoms)
 		return ctx, errorsmod.Wrapf(gaiaerrors.ErrInvalidCoins, "fee is not a subset of required fees; got %s, required: %s", feeTx.GetFee().String(), feeRequired.String())
 	}
 
@@ -89,6 +101,7 @@ func (mfd FeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 	// special case: if feeCoinsNonZeroDenom=[], DenomsSubsetOf returns true
 	// special case: if feeCoinsNonZeroDenom is not empty, but nonZeroCoinFeesReq empty, return false
 	if !feeCoinsNonZeroDenom.DenomsSubsetOf(nonZeroCoinFeesReq) {
+		RecordFeeRejection(ctx, FeeRejectionInvalidDenoms)
 		return ctx, errorsmod.Wrapf(gaiaerrors.ErrInsufficientFee, "fee is not a subset of required fees; got %s, required: %s", feeCoins.String(), feeRequired.String())
 	}
 
@@ -106,6 +119,7 @@ func (mfd FeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 	allowedToBypassMinFee := allBypassMsgs && doesNotExceedMaxGasUsage
 
 	if allowedToBypassMinFee {
+		recordBypass(ctx, gas)
 		return next(ctx, tx, simulate)
 	}
 
@@ -119,12 +133,14 @@ func (mfd FeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 		if len(zeroCoinFeesDenomReq) != 0 {
 			return next(ctx, tx, simulate)
 		}
+		RecordFeeRejection(ctx, feeRejectionReason(allBypassMsgs, doesNotExceedMaxGasUsage))
 		return ctx, errorsmod.Wrapf(gaiaerrors.ErrInsufficientFee, "insufficient fees; got: %s required: %s", feeCoins.String(), feeRequired.String())
 	}
 
 	// when feeCoins != []
 	// special case: if TX has at least one of the zeroCoinFeesDenomReq, then it should pass
 	if len(feeCoinsZeroDenom) > 0 {
+		recordGasPrices(ctx, feeCoins, gas)
 		return next(ctx, tx, simulate)
 	}
 
@@ -141,9 +157,11 @@ func (mfd FeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, ne
 			errMsg = fmt.Sprintf("Insufficient fees; bypass-min-fee-msg-types with gas consumption %v exceeds the maximum allowed gas value of %v.", gas, maxTotalBypassMinFeeMsgGasUsage)
 		}
 
+		RecordFeeRejection(ctx, feeRejectionReason(allBypassMsgs, doesNotExceedMaxGasUsage))
 		return ctx, errorsmod.Wrap(gaiaerrors.ErrInsufficientFee, errMsg)
 	}
 
+	recordGasPrices(ctx, feeCoins, gas)
 	return next(ctx, tx, simulate)
 }
 
@@ -163,6 +181,11 @@ func (mfd FeeDecorator) GetTxFeeRequired(ctx sdk.Context, tx sdk.FeeTx) (sdk.Coi
 		return globalFees, nil
 	}
 
+	// The txs made of the local bypass msg types only pay the global fees
+	if len(mfd.LocalBypassMinFeeMsgTypes) > 0 && ContainsOnlyMsgTypes(tx.GetMsgs(), mfd.LocalBypassMinFeeMsgTypes) {
+		return globalFees, nil
+	}
+
 	// In CheckTx mode, the local and global fee min gas prices are combined
 	// to form the tx fee requirements
 
@@ -194,16 +217,8 @@ func (mfd FeeDecorator) GetGlobalFee(ctx sdk.Context, feeTx sdk.FeeTx) (sdk.Coin
 			return sdk.Coins{}, err
 		}
 	}
-	requiredGlobalFees := make(sdk.Coins, len(globalMinGasPrices))
-	// Determine the required fees by multiplying each required minimum gas
-	// price by the gas limit, where fee = ceil(minGasPrice * gasLimit).
-	glDec := sdk.NewDec(int64(feeTx.GetGas()))
-	for i, gp := range globalMinGasPrices {
-		fee := gp.Amount.Mul(glDec)
-		requiredGlobalFees[i] = sdk.NewCoin(gp.Denom, fee.Ceil().RoundInt())
-	}
 
-	return requiredGlobalFees.Sort(), nil
+	return GetFeesForGas(globalMinGasPrices, feeTx.GetGas()), nil
 }
 
 // DefaultZeroGlobalFee returns a zero coin with the staking module bond denom
@@ -217,10 +232,14 @@ func (mfd FeeDecorator) DefaultZeroGlobalFee(ctx sdk.Context) ([]sdk.DecCoin, er
 }
 
 func (mfd FeeDecorator) ContainsOnlyBypassMinFeeMsgs(ctx sdk.Context, msgs []sdk.Msg) bool {
-	bypassMsgTypes := mfd.GetBypassMsgTypes(ctx)
+	return ContainsOnlyMsgTypes(msgs, mfd.GetBypassMsgTypes(ctx))
+}
 
+// ContainsOnlyMsgTypes returns true if the type URLs of all the msgs are in
+// msgTypes.
+func ContainsOnlyMsgTypes(msgs []sdk.Msg, msgTypes []string) bool {
 	for _, msg := range msgs {
-		if tmstrings.StringInSlice(sdk.MsgTypeURL(msg), bypassMsgTypes) {
+		if tmstrings.StringInSlice(sdk.MsgTypeURL(msg), msgTypes) {
 			continue
 		}
 		return false
@@ -254,11 +273,17 @@ func GetMinGasPrice(ctx sdk.Context, gasLimit int64) sdk.Coins {
 		return sdk.Coins{}
 	}
 
-	requiredFees := make(sdk.Coins, len(minGasPrices))
+	return GetFeesForGas(minGasPrices, uint64(gasLimit))
+}
+
+// GetFeesForGas returns the fees required by the gas prices for a gas limit,
+// sorted in ascending order.
+func GetFeesForGas(gasPrices sdk.DecCoins, gasLimit uint64) sdk.Coins {
+	requiredFees := make(sdk.Coins, len(gasPrices))
 	// Determine the required fees by multiplying each required minimum gas
 	// price by the gas limit, where fee = ceil(minGasPrice * gasLimit).
-	glDec := sdk.NewDec(gasLimit)
-	for i, gp := range minGasPrices {
+	glDec := sdk.NewDecFromInt(sdk.NewIntFromUint64(gasLimit))
+	for i, gp := range gasPrices {
 		fee := gp.Amount.Mul(glDec)
 		requiredFees[i] = sdk.NewCoin(gp.Denom, fee.Ceil().RoundInt())
 	}
@@ -0,0 +1,94 @@
+package ante
+
+import (
+	"github.com/armon/go-metrics"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/gaia/v17/x/globalfee/types"
+)
+
+// The reasons of the fee rejections, the values of the reason label of the
+// rejected txs counter.
+const (
+	FeeRejectionInvalidDenoms     = "invalid_denoms"
+	FeeRejectionInsufficientFees  = "insufficient_fees"
+	FeeRejectionBypassGasExceeded = "bypass_gas_exceeded"
+	FeeRejectionMinPriority       = "min_priority"
+)
+
+const (
+	labelMode   = "mode"
+	labelReason = "reason"
+	labelDenom  = "denom"
+)
+
+// The metrics are exported through the SDK telemetry server. The samples are
+// exported as summaries by the Prometheus sink.
+var (
+	metricRejectedTxs = []string{types.ModuleName, "rejected_txs"}
+	metricBypassTxs   = []string{types.ModuleName, "bypass_txs"}
+	metricBypassGas   = []string{types.ModuleName, "bypass_gas"}
+	metricGasPrice    = []string{types.ModuleName, "gas_price"}
+)
+
+// ModeLabel returns the label of the execution mode of the tx: check, recheck
+// or deliver.
+func ModeLabel(ctx sdk.Context) metrics.Label {
+	switch {
+	case ctx.IsReCheckTx():
+		return telemetry.NewLabel(labelMode, "recheck")
+	case ctx.IsCheckTx():
+		return telemetry.NewLabel(labelMode, "check")
+	default:
+		return telemetry.NewLabel(labelMode, "deliver")
+	}
+}
+
+// RecordFeeRejection counts a tx rejected for its fees, see the
+// FeeRejection reasons.
+func RecordFeeRejection(ctx sdk.Context, reason string) {
+	telemetry.IncrCounterWithLabels(metricRejectedTxs, 1, []metrics.Label{
+		ModeLabel(ctx),
+		telemetry.NewLabel(labelReason, reason),
+	})
+}
+
+// feeRejectionReason returns the reason of the rejection of a tx with
+// insufficient fees: a tx of bypass msgs only is rejected for its gas.
+func feeRejectionReason(allBypassMsgs, doesNotExceedMaxGasUsage bool) string {
+	if allBypassMsgs && !doesNotExceedMaxGasUsage {
+		return FeeRejectionBypassGasExceeded
+	}
+	return FeeRejectionInsufficientFees
+}
+
+// recordBypass counts a tx allowed to bypass the minimum fees and samples its
+// gas limit.
+func recordBypass(ctx sdk.Context, gas uint64) {
+	labels := []metrics.Label{ModeLabel(ctx)}
+	telemetry.IncrCounterWithLabels(metricBypassTxs, 1, labels)
+	metrics.AddSampleWithLabels(metricBypassGas, float32(gas), labels)
+}
+
+// recordGasPrices samples the gas price paid in each denom of the fees of an
+// accepted tx, i.e. the amount divided by the gas limit.
+func recordGasPrices(ctx sdk.Context, fees sdk.Coins, gas uint64) {
+	if gas == 0 {
+		return
+	}
+
+	mode := ModeLabel(ctx)
+	gasDec := sdk.NewDecFromInt(sdk.NewIntFromUint64(gas))
+	for _, fee := range fees {
+		price, err := sdk.NewDecFromInt(fee.Amount).Quo(gasDec).Float64()
+		if err != nil {
+			continue
+		}
+		metrics.AddSampleWithLabels(metricGasPrice, float32(price), []metrics.Label{
+			mode,
+			telemetry.NewLabel(labelDenom, fee.Denom),
+		})
+	}
+}
@@ -0,0 +1,10 @@
+package icq
+
+import (
+	"github.com/cosmos/gaia/v17/x/icq/types"
+)
+
+const (
+	ModuleName = types.ModuleName
+	StoreKey   = types.StoreKey
+)
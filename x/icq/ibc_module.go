@@ -0,0 +1,163 @@
+package icq
+
+import (
+	"strings"
+
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v7/modules/core/05-port/types"
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
+	ibcexported "github.com/cosmos/ibc-go/v7/modules/core/exported"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+
+	"github.com/cosmos/gaia/v17/x/icq/keeper"
+	"github.com/cosmos/gaia/v17/x/icq/types"
+)
+
+var _ porttypes.IBCModule = IBCModule{}
+
+// IBCModule implements the ICS26 interface for the interchain query host
+// given the interchain query keeper.
+type IBCModule struct {
+	keeper keeper.Keeper
+}
+
+// NewIBCModule creates a new IBCModule given the keeper
+func NewIBCModule(k keeper.Keeper) IBCModule {
+	return IBCModule{
+		keeper: k,
+	}
+}
+
+// OnChanOpenInit implements the IBCModule interface. Interchain query
+// channels must be initiated by the querying (controller) chain.
+func (im IBCModule) OnChanOpenInit(
+	_ sdk.Context,
+	_ channeltypes.Order,
+	_ []string,
+	_ string,
+	_ string,
+	_ *capabilitytypes.Capability,
+	_ channeltypes.Counterparty,
+	_ string,
+) (string, error) {
+	return "", errorsmod.Wrap(types.ErrInvalidChannelFlow, "channel handshake must be initiated by controller chain")
+}
+
+// OnChanOpenTry implements the IBCModule interface.
+func (im IBCModule) OnChanOpenTry(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	_ []string,
+	portID,
+	channelID string,
+	chanCap *capabilitytypes.Capability,
+	_ channeltypes.Counterparty,
+	counterpartyVersion string,
+) (string, error) {
+	if !im.keeper.GetParams(ctx).HostEnabled {
+		return "", types.ErrHostDisabled
+	}
+
+	if order != channeltypes.UNORDERED {
+		return "", errorsmod.Wrapf(channeltypes.ErrInvalidChannelOrdering, "expected %s channel, got %s", channeltypes.UNORDERED, order)
+	}
+
+	boundPort := im.keeper.GetPort(ctx)
+	if boundPort != portID {
+		return "", errorsmod.Wrapf(types.ErrInvalidHostPort, "expected %s, got %s", boundPort, portID)
+	}
+
+	if strings.TrimSpace(counterpartyVersion) != types.Version {
+		return "", errorsmod.Wrapf(types.ErrInvalidVersion, "expected %s, got %s", types.Version, counterpartyVersion)
+	}
+
+	// OpenTry must claim the channelCapability that IBC passes into the callback
+	if err := im.keeper.ClaimCapability(ctx, chanCap, host.ChannelCapabilityPath(portID, channelID)); err != nil {
+		return "", err
+	}
+
+	return types.Version, nil
+}
+
+// OnChanOpenAck implements the IBCModule interface
+func (im IBCModule) OnChanOpenAck(
+	_ sdk.Context,
+	_,
+	_ string,
+	_ string,
+	_ string,
+) error {
+	return errorsmod.Wrap(types.ErrInvalidChannelFlow, "channel handshake must be initiated by controller chain")
+}
+
+// OnChanOpenConfirm implements the IBCModule interface
+func (im IBCModule) OnChanOpenConfirm(
+	_ sdk.Context,
+	_,
+	_ string,
+) error {
+	return nil
+}
+
+// OnChanCloseInit implements the IBCModule interface
+func (im IBCModule) OnChanCloseInit(
+	_ sdk.Context,
+	_,
+	_ string,
+) error {
+	// Disallow user-initiated channel closing for interchain query channels
+	return errorsmod.Wrap(types.ErrInvalidChannelFlow, "user cannot close channel")
+}
+
+// OnChanCloseConfirm implements the IBCModule interface
+func (im IBCModule) OnChanCloseConfirm(
+	_ sdk.Context,
+	_,
+	_ string,
+) error {
+	return nil
+}
+
+// OnRecvPacket implements the IBCModule interface. A successful
+// acknowledgement is returned if the queries of the packet were executed,
+// an error acknowledgement otherwise.
+func (im IBCModule) OnRecvPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	_ sdk.AccAddress,
+) ibcexported.Acknowledgement {
+	if !im.keeper.GetParams(ctx).HostEnabled {
+		return channeltypes.NewErrorAcknowledgement(types.ErrHostDisabled)
+	}
+
+	txResponse, err := im.keeper.OnRecvPacket(ctx, packet)
+	if err != nil {
+		im.keeper.Logger(ctx).Error("interchain query failed", "sequence", packet.Sequence, "err", err)
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+
+	return channeltypes.NewResultAcknowledgement(txResponse)
+}
+
+// OnAcknowledgementPacket implements the IBCModule interface
+func (im IBCModule) OnAcknowledgementPacket(
+	_ sdk.Context,
+	_ channeltypes.Packet,
+	_ []byte,
+	_ sdk.AccAddress,
+) error {
+	return errorsmod.Wrap(types.ErrInvalidChannelFlow, "cannot receive acknowledgement on a host channel end, a host chain does not send a packet over the channel")
+}
+
+// OnTimeoutPacket implements the IBCModule interface
+func (im IBCModule) OnTimeoutPacket(
+	_ sdk.Context,
+	_ channeltypes.Packet,
+	_ sdk.AccAddress,
+) error {
+	return errorsmod.Wrap(types.ErrInvalidChannelFlow, "cannot cause a packet timeout on a host channel end, a host chain does not send a packet over the channel")
+}
@@ -0,0 +1,32 @@
+package keeper
+
+import (
+	"fmt"
+
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/gaia/v17/x/icq/types"
+)
+
+// InitGenesis binds the host port and sets the params from the provided genesis state
+func (k Keeper) InitGenesis(ctx sdk.Context, state types.GenesisState) {
+	k.SetPort(ctx, state.HostPort)
+
+	// Only try to bind to port if it is not already bound, since we may already own
+	// port capability from capability InitGenesis
+	if !k.IsBound(ctx, state.HostPort) {
+		cap := k.BindPort(ctx, state.HostPort)
+		if err := k.ClaimCapability(ctx, cap, host.PortPath(state.HostPort)); err != nil {
+			panic(fmt.Sprintf("could not claim port capability: %v", err))
+		}
+	}
+
+	k.SetParams(ctx, state.Params)
+}
+
+// ExportGenesis returns the interchain query host exported genesis
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	return types.NewGenesisState(k.GetPort(ctx), k.GetParams(ctx))
+}
@@ -0,0 +1,20 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/gaia/v17/x/icq/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// Params returns the interchain query host settings in effect
+func (k Keeper) Params(stdCtx context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(stdCtx)
+
+	return &types.QueryParamsResponse{
+		Params: k.GetParams(ctx),
+	}, nil
+}
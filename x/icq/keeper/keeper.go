@@ -0,0 +1,102 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cometbft/cometbft/libs/log"
+
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
+	"github.com/cosmos/ibc-go/v7/modules/core/exported"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/cosmos/gaia/v17/x/icq/types"
+)
+
+// Keeper defines the interchain query host keeper
+type Keeper struct {
+	storeKey   storetypes.StoreKey
+	paramSpace paramtypes.Subspace
+
+	portKeeper   types.PortKeeper
+	scopedKeeper exported.ScopedKeeper
+
+	querier types.GRPCQueryRouter
+}
+
+// NewKeeper creates a new interchain query host Keeper instance
+func NewKeeper(
+	key storetypes.StoreKey, paramSpace paramtypes.Subspace,
+	portKeeper types.PortKeeper, scopedKeeper exported.ScopedKeeper, querier types.GRPCQueryRouter,
+) Keeper {
+	// set KeyTable if it has not already been set
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	}
+
+	return Keeper{
+		storeKey:     key,
+		paramSpace:   paramSpace,
+		portKeeper:   portKeeper,
+		scopedKeeper: scopedKeeper,
+		querier:      querier,
+	}
+}
+
+// Logger returns the application logger, scoped to the associated module
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// GetPort returns the port ID the host is bound to
+func (k Keeper) GetPort(ctx sdk.Context) string {
+	store := ctx.KVStore(k.storeKey)
+	return string(store.Get(types.PortKey))
+}
+
+// SetPort sets the port ID the host is bound to
+func (k Keeper) SetPort(ctx sdk.Context, portID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.PortKey, []byte(portID))
+}
+
+// BindPort stores the provided portID and binds to it, returning the associated capability
+func (k Keeper) BindPort(ctx sdk.Context, portID string) *capabilitytypes.Capability {
+	k.SetPort(ctx, portID)
+
+	return k.portKeeper.BindPort(ctx, portID)
+}
+
+// IsBound checks if the interchain query host module is already bound to the desired port
+func (k Keeper) IsBound(ctx sdk.Context, portID string) bool {
+	_, ok := k.scopedKeeper.GetCapability(ctx, host.PortPath(portID))
+	return ok
+}
+
+// AuthenticateCapability wraps the scopedKeeper's AuthenticateCapability function
+func (k Keeper) AuthenticateCapability(ctx sdk.Context, cap *capabilitytypes.Capability, name string) bool {
+	return k.scopedKeeper.AuthenticateCapability(ctx, cap, name)
+}
+
+// ClaimCapability wraps the scopedKeeper's ClaimCapability function
+func (k Keeper) ClaimCapability(ctx sdk.Context, cap *capabilitytypes.Capability, name string) error {
+	return k.scopedKeeper.ClaimCapability(ctx, cap, name)
+}
+
+// GetParams returns the params in effect. Params that are not set in the store,
+// e.g. before the module is initialized by an upgrade, fall back to their
+// default values.
+func (k Keeper) GetParams(ctx sdk.Context) types.Params {
+	params := types.DefaultParams()
+	k.paramSpace.GetParamSetIfExists(ctx, &params)
+
+	return params
+}
+
+// SetParams sets the interchain query host params
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
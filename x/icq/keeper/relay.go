@@ -0,0 +1,97 @@
+package keeper
+
+import (
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+
+	errorsmod "cosmossdk.io/errors"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/gaia/v17/x/icq/types"
+)
+
+// OnRecvPacket handles a given interchain query packet on the host chain. It
+// returns the JSON encoded acknowledgement holding the query responses.
+func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet) ([]byte, error) {
+	var data types.InterchainQueryPacketData
+	if err := types.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		// UnmarshalJSON errors are indeterminate and therefore are not wrapped and included in failed acks
+		return nil, errorsmod.Wrapf(types.ErrUnknownDataType, "cannot unmarshal ICQ packet data")
+	}
+
+	if err := data.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	reqs, err := types.DeserializeCosmosQuery(data.GetData())
+	if err != nil {
+		return nil, errorsmod.Wrapf(types.ErrUnknownDataType, "cannot deserialize cosmos query")
+	}
+
+	return k.executeQueries(ctx, reqs)
+}
+
+// executeQueries runs the requested queries against the state of the current
+// block, including the changes of its previous txs, in a cache context. All
+// requests must be allowed by the params, and the queries of a
+// single packet may not consume more than MaxQueryGas, which is charged to
+// the relayer's transaction as well.
+func (k Keeper) executeQueries(ctx sdk.Context, reqs []abci.RequestQuery) (ack []byte, err error) {
+	params := k.GetParams(ctx)
+
+	// queries must not modify state, any write is discarded with the cache context
+	queryCtx, _ := ctx.CacheContext()
+	queryCtx = queryCtx.WithGasMeter(storetypes.NewGasMeter(params.MaxQueryGas))
+
+	defer func() {
+		if r := recover(); r != nil {
+			outOfGas, ok := r.(storetypes.ErrorOutOfGas)
+			if !ok {
+				panic(r)
+			}
+			ack, err = nil, errorsmod.Wrapf(types.ErrQueryGasLimitExceeded, "out of gas in location: %s; gas limit: %d", outOfGas.Descriptor, params.MaxQueryGas)
+		}
+
+		ctx.GasMeter().ConsumeGas(queryCtx.GasMeter().GasConsumedToLimit(), "interchain queries")
+	}()
+
+	resps := make([]abci.ResponseQuery, len(reqs))
+	for i, req := range reqs {
+		if req.Height != 0 || req.Prove {
+			return nil, errorsmod.Wrapf(types.ErrInvalidQuery, "query height and proofs are not supported: %s", req.Path)
+		}
+
+		if !params.IsQueryAllowed(req.Path) {
+			return nil, errorsmod.Wrap(types.ErrQueryNotAllowed, req.Path)
+		}
+
+		route := k.querier.Route(req.Path)
+		if route == nil {
+			return nil, errorsmod.Wrapf(types.ErrQueryNotAllowed, "no route found for: %s", req.Path)
+		}
+
+		res, err := route(queryCtx, req)
+		if err != nil {
+			return nil, err
+		}
+
+		// only keep the deterministic fields of the response
+		resps[i] = abci.ResponseQuery{
+			Code:   res.Code,
+			Index:  res.Index,
+			Key:    res.Key,
+			Value:  res.Value,
+			Height: res.Height,
+		}
+	}
+
+	bz, err := types.SerializeCosmosResponse(resps)
+	if err != nil {
+		return nil, err
+	}
+
+	return types.InterchainQueryPacketAck{Data: bz}.GetBytes(), nil
+}
@@ -0,0 +1,49 @@
+package types
+
+import (
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+)
+
+// ModuleCdc references the global interchain query module codec. Note, the
+// codec is used to encode the packet data and acknowledgements sent over IBC,
+// which only contain plain proto messages.
+var ModuleCdc = codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+
+// SerializeCosmosQuery serializes the given ABCI query requests into the
+// packet data payload expected by the host.
+func SerializeCosmosQuery(reqs []abci.RequestQuery) ([]byte, error) {
+	q := &CosmosQuery{
+		Requests: reqs,
+	}
+	return ModuleCdc.Marshal(q)
+}
+
+// DeserializeCosmosQuery decodes the packet data payload into ABCI query requests.
+func DeserializeCosmosQuery(bz []byte) ([]abci.RequestQuery, error) {
+	var q CosmosQuery
+	if err := ModuleCdc.Unmarshal(bz, &q); err != nil {
+		return nil, err
+	}
+	return q.Requests, nil
+}
+
+// SerializeCosmosResponse serializes the given ABCI query responses into the
+// acknowledgement payload returned to the controller.
+func SerializeCosmosResponse(resps []abci.ResponseQuery) ([]byte, error) {
+	r := &CosmosResponse{
+		Responses: resps,
+	}
+	return ModuleCdc.Marshal(r)
+}
+
+// DeserializeCosmosResponse decodes the acknowledgement payload into ABCI query responses.
+func DeserializeCosmosResponse(bz []byte) ([]abci.ResponseQuery, error) {
+	var r CosmosResponse
+	if err := ModuleCdc.Unmarshal(bz, &r); err != nil {
+		return nil, err
+	}
+	return r.Responses, nil
+}
@@ -0,0 +1,16 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+var (
+	ErrUnknownDataType       = errorsmod.Register(ModuleName, 2, "unknown data type")
+	ErrInvalidChannelFlow    = errorsmod.Register(ModuleName, 3, "invalid message sent to channel end")
+	ErrInvalidHostPort       = errorsmod.Register(ModuleName, 4, "invalid host port")
+	ErrHostDisabled          = errorsmod.Register(ModuleName, 5, "host is disabled")
+	ErrInvalidVersion        = errorsmod.Register(ModuleName, 6, "invalid version")
+	ErrQueryNotAllowed       = errorsmod.Register(ModuleName, 7, "query path not allowed")
+	ErrInvalidQuery          = errorsmod.Register(ModuleName, 8, "invalid query")
+	ErrQueryGasLimitExceeded = errorsmod.Register(ModuleName, 9, "query gas limit exceeded")
+)
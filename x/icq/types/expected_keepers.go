@@ -0,0 +1,18 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+)
+
+// PortKeeper defines the expected IBC port keeper
+type PortKeeper interface {
+	BindPort(ctx sdk.Context, portID string) *capabilitytypes.Capability
+}
+
+// GRPCQueryRouter defines the expected gRPC query router used to execute
+// the interchain queries, e.g. baseapp.GRPCQueryRouter
+type GRPCQueryRouter interface {
+	Route(path string) baseapp.GRPCQueryHandler
+}
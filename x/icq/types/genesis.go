@@ -0,0 +1,32 @@
+package types
+
+import (
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
+
+	errorsmod "cosmossdk.io/errors"
+)
+
+// NewGenesisState - Create a new genesis state
+func NewGenesisState(hostPort string, params Params) *GenesisState {
+	return &GenesisState{
+		HostPort: hostPort,
+		Params:   params,
+	}
+}
+
+// DefaultGenesisState - Return a default genesis state
+func DefaultGenesisState() *GenesisState {
+	return NewGenesisState(PortID, DefaultParams())
+}
+
+func ValidateGenesis(data GenesisState) error {
+	if err := host.PortIdentifierValidator(data.HostPort); err != nil {
+		return errorsmod.Wrap(err, "interchainquery host port")
+	}
+
+	if err := data.Params.ValidateBasic(); err != nil {
+		return errorsmod.Wrap(err, "interchainquery params")
+	}
+
+	return nil
+}
@@ -0,0 +1,641 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: gaia/icq/v1/genesis.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// GenesisState defines the interchain query host genesis state
+type GenesisState struct {
+	// host_port is the port the ICQ host is bound to
+	HostPort string `protobuf:"bytes,1,opt,name=host_port,json=hostPort,proto3" json:"host_port,omitempty" yaml:"host_port"`
+	// Params of this module
+	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params,omitempty"`
+}
+
+func (m *GenesisState) Reset()         { *m = GenesisState{} }
+func (m *GenesisState) String() string { return proto.CompactTextString(m) }
+func (*GenesisState) ProtoMessage()    {}
+func (*GenesisState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cba20369b5e8324a, []int{0}
+}
+func (m *GenesisState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenesisState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenesisState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GenesisState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisState.Merge(m, src)
+}
+func (m *GenesisState) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenesisState) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisState proto.InternalMessageInfo
+
+func (m *GenesisState) GetHostPort() string {
+	if m != nil {
+		return m.HostPort
+	}
+	return ""
+}
+
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+// Params defines the interchain query host settings. They determine which
+// state counterparty chains can read and how much gas they may spend doing
+// so, and therefore are managed by governance.
+type Params struct {
+	// host_enabled enables or disables the host submodule.
+	HostEnabled bool `protobuf:"varint,1,opt,name=host_enabled,json=hostEnabled,proto3" json:"host_enabled,omitempty" yaml:"host_enabled"`
+	// allow_queries defines a list of gRPC query paths that counterparty chains
+	// are allowed to query, e.g. "/cosmos.bank.v1beta1.Query/Balance".
+	AllowQueries []string `protobuf:"bytes,2,rep,name=allow_queries,json=allowQueries,proto3" json:"allow_queries,omitempty" yaml:"allow_queries"`
+	// max_query_gas defines the gas limit for executing all queries of a
+	// single packet.
+	MaxQueryGas uint64 `protobuf:"varint,3,opt,name=max_query_gas,json=maxQueryGas,proto3" json:"max_query_gas,omitempty" yaml:"max_query_gas"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cba20369b5e8324a, []int{1}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetHostEnabled() bool {
+	if m != nil {
+		return m.HostEnabled
+	}
+	return false
+}
+
+func (m *Params) GetAllowQueries() []string {
+	if m != nil {
+		return m.AllowQueries
+	}
+	return nil
+}
+
+func (m *Params) GetMaxQueryGas() uint64 {
+	if m != nil {
+		return m.MaxQueryGas
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*GenesisState)(nil), "gaia.icq.v1.GenesisState")
+	proto.RegisterType((*Params)(nil), "gaia.icq.v1.Params")
+}
+
+func init() { proto.RegisterFile("gaia/icq/v1/genesis.proto", fileDescriptor_cba20369b5e8324a) }
+
+var fileDescriptor_cba20369b5e8324a = []byte{
+	// 345 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x91, 0x31, 0x6f, 0xea, 0x30,
+	0x1c, 0xc4, 0xe3, 0xc7, 0x13, 0x02, 0x07, 0x24, 0x14, 0x90, 0x5e, 0x5e, 0x87, 0x04, 0x79, 0xca,
+	0x50, 0x25, 0xa2, 0xdd, 0x10, 0x5d, 0x22, 0x21, 0x56, 0x9a, 0x6e, 0x5d, 0x90, 0x49, 0xad, 0x60,
+	0x29, 0xc6, 0x21, 0x36, 0x94, 0x7c, 0x83, 0x8e, 0xfd, 0x4a, 0xdd, 0x18, 0x19, 0x3b, 0x45, 0x15,
+	0x6c, 0x1d, 0xf9, 0x04, 0x55, 0x6c, 0x54, 0xc1, 0xe6, 0xf3, 0xef, 0x4e, 0xff, 0x93, 0x0e, 0xfe,
+	0x4f, 0x30, 0xc5, 0x01, 0x8d, 0x57, 0xc1, 0x66, 0x10, 0x24, 0x64, 0x49, 0x04, 0x15, 0x7e, 0x96,
+	0x73, 0xc9, 0x2d, 0xb3, 0x42, 0x3e, 0x8d, 0x57, 0xfe, 0x66, 0x70, 0xd3, 0x4b, 0x78, 0xc2, 0xd5,
+	0x7f, 0x50, 0xbd, 0xb4, 0x05, 0xbd, 0x01, 0xd8, 0x9a, 0xe8, 0xd0, 0x93, 0xc4, 0x92, 0x58, 0x03,
+	0xd8, 0x5c, 0x70, 0x21, 0x67, 0x19, 0xcf, 0xa5, 0x0d, 0xfa, 0xc0, 0x6b, 0x86, 0xbd, 0x53, 0xe9,
+	0x76, 0x0a, 0xcc, 0xd2, 0x21, 0xfa, 0x45, 0x28, 0x6a, 0x54, 0xef, 0x29, 0xcf, 0xa5, 0x35, 0x86,
+	0xf5, 0x0c, 0xe7, 0x98, 0x09, 0xfb, 0x4f, 0x1f, 0x78, 0xe6, 0x5d, 0xd7, 0xbf, 0xb8, 0xeb, 0x4f,
+	0x15, 0x0a, 0xed, 0x5d, 0xe9, 0x1a, 0xdf, 0xa5, 0xdb, 0xd1, 0xd6, 0x5b, 0xce, 0xa8, 0x24, 0x2c,
+	0x93, 0x45, 0x74, 0x0e, 0xa3, 0x0f, 0x00, 0xeb, 0xda, 0x6c, 0x0d, 0x61, 0x4b, 0x5d, 0x22, 0x4b,
+	0x3c, 0x4f, 0xc9, 0x8b, 0xea, 0xd1, 0x08, 0xff, 0x9d, 0x4a, 0xb7, 0x7b, 0xd1, 0xe3, 0x4c, 0x51,
+	0x64, 0x56, 0x72, 0xac, 0x95, 0xf5, 0x00, 0xdb, 0x38, 0x4d, 0xf9, 0xeb, 0x6c, 0xb5, 0x26, 0x39,
+	0x25, 0x55, 0xa9, 0x9a, 0xd7, 0x0c, 0xed, 0x53, 0xe9, 0xf6, 0x74, 0xf8, 0x0a, 0xa3, 0xa8, 0xa5,
+	0xf4, 0xa3, 0x96, 0xd6, 0x08, 0xb6, 0x19, 0xde, 0x2a, 0x5a, 0xcc, 0x12, 0x2c, 0xec, 0x5a, 0x1f,
+	0x78, 0x7f, 0x2f, 0xe3, 0x57, 0x18, 0x45, 0x26, 0xc3, 0xdb, 0x2a, 0x5c, 0x4c, 0xb0, 0x08, 0x47,
+	0xbb, 0x83, 0x03, 0xf6, 0x07, 0x07, 0x7c, 0x1d, 0x1c, 0xf0, 0x7e, 0x74, 0x8c, 0xfd, 0xd1, 0x31,
+	0x3e, 0x8f, 0x8e, 0xf1, 0x8c, 0x12, 0x2a, 0x17, 0xeb, 0xb9, 0x1f, 0x73, 0x16, 0xc4, 0x5c, 0x30,
+	0x2e, 0x02, 0x35, 0xdc, 0x56, 0x4d, 0x27, 0x8b, 0x8c, 0x88, 0x79, 0x5d, 0x6d, 0x72, 0xff, 0x33,
+	0x00, 0xa7, 0xc1, 0xc2, 0x35, 0xd3, 0x01, 0x00, 0x00,
+}
+
+func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.HostPort) > 0 {
+		i -= len(m.HostPort)
+		copy(dAtA[i:], m.HostPort)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.HostPort)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxQueryGas != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxQueryGas))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.AllowQueries) > 0 {
+		for iNdEx := len(m.AllowQueries) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowQueries[iNdEx])
+			copy(dAtA[i:], m.AllowQueries[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.AllowQueries[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.HostEnabled {
+		i--
+		if m.HostEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.HostPort)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.HostEnabled {
+		n += 2
+	}
+	if len(m.AllowQueries) > 0 {
+		for _, s := range m.AllowQueries {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.MaxQueryGas != 0 {
+		n += 1 + sovGenesis(uint64(m.MaxQueryGas))
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostPort", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HostPort = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HostEnabled = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowQueries", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowQueries = append(m.AllowQueries, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxQueryGas", wireType)
+			}
+			m.MaxQueryGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxQueryGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipGenesis(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthGenesis
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupGenesis
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthGenesis
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthGenesis        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowGenesis          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupGenesis = fmt.Errorf("proto: unexpected end of group")
+)
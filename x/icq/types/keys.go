@@ -0,0 +1,21 @@
+package types
+
+const (
+	// ModuleName is the name of the this module
+	ModuleName = "interchainquery"
+
+	// StoreKey is the store key string for the interchain query module
+	StoreKey = ModuleName
+
+	// QuerierRoute is the querier route for the interchain query module
+	QuerierRoute = ModuleName
+
+	// Version defines the current version the ICQ host module supports
+	Version = "icq-1"
+
+	// PortID is the default port id that the ICQ host module binds to
+	PortID = "icqhost"
+)
+
+// PortKey defines the key to store the port ID in store
+var PortKey = []byte{0x01}
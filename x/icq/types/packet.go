@@ -0,0 +1,24 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// ValidateBasic performs basic validation of the interchain query packet data.
+func (p InterchainQueryPacketData) ValidateBasic() error {
+	if len(p.Data) == 0 {
+		return errorsmod.Wrap(ErrInvalidQuery, "packet data cannot be empty")
+	}
+
+	return nil
+}
+
+// GetBytes returns the JSON marshalled interchain query packet data.
+func (p InterchainQueryPacketData) GetBytes() []byte {
+	return ModuleCdc.MustMarshalJSON(&p)
+}
+
+// GetBytes returns the JSON marshalled interchain query packet acknowledgement.
+func (a InterchainQueryPacketAck) GetBytes() []byte {
+	return ModuleCdc.MustMarshalJSON(&a)
+}
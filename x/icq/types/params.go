@@ -0,0 +1,137 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	gaiaerrors "github.com/cosmos/gaia/v17/types/errors"
+)
+
+var (
+	ParamStoreKeyHostEnabled  = []byte("HostEnabled")
+	ParamStoreKeyAllowQueries = []byte("AllowQueries")
+	ParamStoreKeyMaxQueryGas  = []byte("MaxQueryGas")
+
+	// DefaultHostEnabled enables the host so that counterparty chains can run
+	// the DefaultAllowQueries right away.
+	DefaultHostEnabled = true
+	// DefaultAllowQueries allows querying bank balances and staking state.
+	DefaultAllowQueries = []string{
+		"/cosmos.bank.v1beta1.Query/Balance",
+		"/cosmos.bank.v1beta1.Query/AllBalances",
+		"/cosmos.staking.v1beta1.Query/Validator",
+		"/cosmos.staking.v1beta1.Query/Delegation",
+	}
+	// DefaultMaxQueryGas bounds the gas spent on the queries of a single packet.
+	DefaultMaxQueryGas uint64 = 1_000_000
+)
+
+// NewParams creates a new parameter configuration for the interchain query host
+func NewParams(enableHost bool, allowQueries []string, maxQueryGas uint64) Params {
+	return Params{
+		HostEnabled:  enableHost,
+		AllowQueries: allowQueries,
+		MaxQueryGas:  maxQueryGas,
+	}
+}
+
+// DefaultParams returns default parameters
+func DefaultParams() Params {
+	return NewParams(DefaultHostEnabled, DefaultAllowQueries, DefaultMaxQueryGas)
+}
+
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// ValidateBasic performs basic validation.
+func (p Params) ValidateBasic() error {
+	if err := validateEnabled(p.HostEnabled); err != nil {
+		return err
+	}
+
+	if err := validateAllowlist(p.AllowQueries); err != nil {
+		return err
+	}
+
+	return validateMaxQueryGas(p.MaxQueryGas)
+}
+
+// ParamSetPairs returns the parameter set pairs.
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(
+			ParamStoreKeyHostEnabled, &p.HostEnabled, validateEnabled,
+		),
+		paramtypes.NewParamSetPair(
+			ParamStoreKeyAllowQueries, &p.AllowQueries, validateAllowlist,
+		),
+		paramtypes.NewParamSetPair(
+			ParamStoreKeyMaxQueryGas, &p.MaxQueryGas, validateMaxQueryGas,
+		),
+	}
+}
+
+// IsQueryAllowed returns true if the given gRPC query path is in the allow list.
+func (p Params) IsQueryAllowed(path string) bool {
+	for _, allowed := range p.AllowQueries {
+		if allowed == path {
+			return true
+		}
+	}
+
+	return false
+}
+
+func validateEnabled(i interface{}) error {
+	_, ok := i.(bool)
+	if !ok {
+		return errorsmod.Wrapf(gaiaerrors.ErrInvalidType, "type: %T, expected bool", i)
+	}
+
+	return nil
+}
+
+// validateAllowlist checks that every entry is a unique, fully qualified
+// gRPC method path such as "/cosmos.bank.v1beta1.Query/Balance".
+func validateAllowlist(i interface{}) error {
+	allowQueries, ok := i.([]string)
+	if !ok {
+		return errorsmod.Wrapf(gaiaerrors.ErrInvalidType, "type: %T, expected []string", i)
+	}
+
+	seen := make(map[string]struct{}, len(allowQueries))
+	for _, path := range allowQueries {
+		if strings.TrimSpace(path) == "" {
+			return fmt.Errorf("allowed query path cannot be blank")
+		}
+
+		if !strings.HasPrefix(path, "/") || strings.Count(path, "/") != 2 {
+			return fmt.Errorf("invalid allowed query path, expected /<service>/<method>: %s", path)
+		}
+
+		if _, ok := seen[path]; ok {
+			return fmt.Errorf("duplicate allowed query path: %s", path)
+		}
+		seen[path] = struct{}{}
+	}
+
+	return nil
+}
+
+func validateMaxQueryGas(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return errorsmod.Wrapf(gaiaerrors.ErrInvalidType, "type: %T, expected uint64", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("max query gas must be positive")
+	}
+
+	return nil
+}
@@ -0,0 +1,77 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDefaultParams(t *testing.T) {
+	p := DefaultParams()
+	require.Equal(t, DefaultHostEnabled, p.HostEnabled)
+	require.Equal(t, DefaultAllowQueries, p.AllowQueries)
+	require.Equal(t, DefaultMaxQueryGas, p.MaxQueryGas)
+	require.NoError(t, p.ValidateBasic())
+}
+
+func TestParamsValidateBasic(t *testing.T) {
+	tests := map[string]struct {
+		params    Params
+		expectErr bool
+	}{
+		"default params, pass": {
+			DefaultParams(),
+			false,
+		},
+		"empty allow list, pass": {
+			NewParams(true, []string{}, 1),
+			false,
+		},
+		"blank query path, fail": {
+			NewParams(true, []string{" "}, 1),
+			true,
+		},
+		"query path without method, fail": {
+			NewParams(true, []string{"/cosmos.bank.v1beta1.Query"}, 1),
+			true,
+		},
+		"duplicate query path, fail": {
+			NewParams(true, []string{"/cosmos.bank.v1beta1.Query/Balance", "/cosmos.bank.v1beta1.Query/Balance"}, 1),
+			true,
+		},
+		"zero max query gas, fail": {
+			NewParams(true, DefaultAllowQueries, 0),
+			true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := test.params.ValidateBasic()
+			if test.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestParamsIsQueryAllowed(t *testing.T) {
+	p := DefaultParams()
+	require.True(t, p.IsQueryAllowed("/cosmos.bank.v1beta1.Query/Balance"))
+	require.False(t, p.IsQueryAllowed("/cosmos.bank.v1beta1.Query/balance"))
+	require.False(t, p.IsQueryAllowed("/cosmos.auth.v1beta1.Query/Accounts"))
+}
+
+func TestValidateGenesis(t *testing.T) {
+	require.NoError(t, ValidateGenesis(*DefaultGenesisState()))
+	require.Error(t, ValidateGenesis(*NewGenesisState("", DefaultParams())))
+	require.Error(t, ValidateGenesis(*NewGenesisState(PortID, NewParams(true, nil, 0))))
+}
+
+func Test_validateTypes(t *testing.T) {
+	require.Error(t, validateEnabled("true"))
+	require.Error(t, validateAllowlist("/cosmos.bank.v1beta1.Query/Balance"))
+	require.Error(t, validateMaxQueryGas(int64(1)))
+}
@@ -0,0 +1,9 @@
+package pfmconfig
+
+import (
+	"github.com/cosmos/gaia/v17/x/pfmconfig/types"
+)
+
+const (
+	ModuleName = types.ModuleName
+)
@@ -0,0 +1,106 @@
+package pfmconfig
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	dbm "github.com/cometbft/cometbft-db"
+	"github.com/cometbft/cometbft/libs/log"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/store"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	gaiaparams "github.com/cosmos/gaia/v17/app/params"
+	"github.com/cosmos/gaia/v17/x/pfmconfig/types"
+)
+
+func TestDefaultGenesis(t *testing.T) {
+	encCfg := gaiaparams.MakeEncodingConfig()
+	gotJSON := AppModuleBasic{}.DefaultGenesis(encCfg.Marshaler)
+	assert.JSONEq(t,
+		`{"params":{"retries_on_timeout":0,"forward_timeout":"600s","refund_timeout":"2419200s"}}`,
+		string(gotJSON), string(gotJSON))
+}
+
+func TestValidateGenesis(t *testing.T) {
+	encCfg := gaiaparams.MakeEncodingConfig()
+	specs := map[string]struct {
+		src    string
+		expErr bool
+	}{
+		"all good": {
+			src: `{"params":{"retries_on_timeout":3,"forward_timeout":"600s","refund_timeout":"86400s"}}`,
+		},
+		"retries overflow": {
+			src:    `{"params":{"retries_on_timeout":256,"forward_timeout":"600s","refund_timeout":"86400s"}}`,
+			expErr: true,
+		},
+		"timeouts not set": {
+			src:    `{"params":{"retries_on_timeout":3}}`,
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			gotErr := AppModuleBasic{}.ValidateGenesis(encCfg.Marshaler, nil, []byte(spec.src))
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+		})
+	}
+}
+
+func TestInitExportGenesis(t *testing.T) {
+	ctx, encCfg, subspace := setupTestStore(t)
+	module := NewAppModule(subspace)
+
+	// params fall back to their defaults before genesis is initialized
+	require.Equal(t, types.DefaultParams(), GetParams(ctx, subspace))
+
+	myParams := types.Params{
+		RetriesOnTimeout: 2,
+		ForwardTimeout:   10 * time.Minute,
+		RefundTimeout:    24 * time.Hour,
+	}
+	genesisState := encCfg.Marshaler.MustMarshalJSON(types.NewGenesisState(myParams))
+	module.InitGenesis(ctx, encCfg.Marshaler, genesisState)
+	require.Equal(t, myParams, GetParams(ctx, subspace))
+
+	var exported types.GenesisState
+	encCfg.Marshaler.MustUnmarshalJSON(module.ExportGenesis(ctx, encCfg.Marshaler), &exported)
+	require.Equal(t, myParams, exported.Params)
+}
+
+func setupTestStore(t *testing.T) (sdk.Context, gaiaparams.EncodingConfig, paramstypes.Subspace) {
+	t.Helper()
+	db := dbm.NewMemDB()
+	ms := store.NewCommitMultiStore(db)
+	encCfg := gaiaparams.MakeEncodingConfig()
+	keyParams := sdk.NewKVStoreKey(paramstypes.StoreKey)
+	tkeyParams := sdk.NewTransientStoreKey(paramstypes.TStoreKey)
+	ms.MountStoreWithDB(keyParams, storetypes.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(tkeyParams, storetypes.StoreTypeTransient, db)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	subspace := paramstypes.NewSubspace(encCfg.Marshaler,
+		encCfg.Amino,
+		keyParams,
+		tkeyParams,
+		paramstypes.ModuleName,
+	).WithKeyTable(types.ParamKeyTable())
+
+	ctx := sdk.NewContext(ms, tmproto.Header{
+		Height: 1234567,
+		Time:   time.Date(2020, time.April, 22, 12, 0, 0, 0, time.UTC),
+	}, false, log.NewNopLogger())
+
+	return ctx, encCfg, subspace
+}
@@ -0,0 +1,44 @@
+package pfmconfig
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/cosmos/gaia/v17/x/pfmconfig/types"
+)
+
+var _ types.QueryServer = &GrpcQuerier{}
+
+// ParamSource is a read only subset of paramtypes.Subspace
+type ParamSource interface {
+	GetParamSetIfExists(ctx sdk.Context, ps paramstypes.ParamSet)
+}
+
+// GetParams returns the params in effect. Params that are not set in the store,
+// e.g. before the module is initialized by an upgrade, fall back to their
+// default values.
+func GetParams(ctx sdk.Context, paramSource ParamSource) types.Params {
+	params := types.DefaultParams()
+	paramSource.GetParamSetIfExists(ctx, &params)
+
+	return params
+}
+
+type GrpcQuerier struct {
+	paramSource ParamSource
+}
+
+func NewGrpcQuerier(paramSource ParamSource) GrpcQuerier {
+	return GrpcQuerier{paramSource: paramSource}
+}
+
+// Params returns the packet forward middleware settings in effect
+func (g GrpcQuerier) Params(stdCtx context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(stdCtx)
+
+	return &types.QueryParamsResponse{
+		Params: GetParams(ctx, g.paramSource),
+	}, nil
+}
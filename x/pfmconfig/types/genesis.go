@@ -0,0 +1,25 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// NewGenesisState - Create a new genesis state
+func NewGenesisState(params Params) *GenesisState {
+	return &GenesisState{
+		Params: params,
+	}
+}
+
+// DefaultGenesisState - Return a default genesis state
+func DefaultGenesisState() *GenesisState {
+	return NewGenesisState(DefaultParams())
+}
+
+func ValidateGenesis(data GenesisState) error {
+	if err := data.Params.ValidateBasic(); err != nil {
+		return errorsmod.Wrap(err, "pfmconfig params")
+	}
+
+	return nil
+}
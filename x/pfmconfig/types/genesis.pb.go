@@ -0,0 +1,603 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: gaia/pfmconfig/v1beta1/genesis.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// GenesisState - initial state of module
+type GenesisState struct {
+	// Params of this module
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+}
+
+func (m *GenesisState) Reset()         { *m = GenesisState{} }
+func (m *GenesisState) String() string { return proto.CompactTextString(m) }
+func (*GenesisState) ProtoMessage()    {}
+func (*GenesisState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_332290cfa6da47fa, []int{0}
+}
+func (m *GenesisState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenesisState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenesisState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GenesisState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisState.Merge(m, src)
+}
+func (m *GenesisState) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenesisState) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisState proto.InternalMessageInfo
+
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+// Params defines the packet forward middleware settings applied to the
+// transfer stack. They are consensus-critical since they determine the
+// timeout of forwarded packets and how many times a timed out packet is
+// retried, and therefore are managed by governance.
+type Params struct {
+	// retries_on_timeout defines how many times a forwarded packet that timed
+	// out is sent again before refunding the sender. Must fit into a uint8.
+	RetriesOnTimeout uint32 `protobuf:"varint,1,opt,name=retries_on_timeout,json=retriesOnTimeout,proto3" json:"retries_on_timeout,omitempty" yaml:"retries_on_timeout"`
+	// forward_timeout defines the relative timeout of forwarded packets.
+	ForwardTimeout time.Duration `protobuf:"bytes,2,opt,name=forward_timeout,json=forwardTimeout,proto3,stdduration" json:"forward_timeout" yaml:"forward_timeout"`
+	// refund_timeout defines the relative timeout of refund packets sent back
+	// to the sender chain after a failed forward.
+	RefundTimeout time.Duration `protobuf:"bytes,3,opt,name=refund_timeout,json=refundTimeout,proto3,stdduration" json:"refund_timeout" yaml:"refund_timeout"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_332290cfa6da47fa, []int{1}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetRetriesOnTimeout() uint32 {
+	if m != nil {
+		return m.RetriesOnTimeout
+	}
+	return 0
+}
+
+func (m *Params) GetForwardTimeout() time.Duration {
+	if m != nil {
+		return m.ForwardTimeout
+	}
+	return 0
+}
+
+func (m *Params) GetRefundTimeout() time.Duration {
+	if m != nil {
+		return m.RefundTimeout
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*GenesisState)(nil), "gaia.pfmconfig.v1beta1.GenesisState")
+	proto.RegisterType((*Params)(nil), "gaia.pfmconfig.v1beta1.Params")
+}
+
+func init() {
+	proto.RegisterFile("gaia/pfmconfig/v1beta1/genesis.proto", fileDescriptor_332290cfa6da47fa)
+}
+
+var fileDescriptor_332290cfa6da47fa = []byte{
+	// 366 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0x31, 0x6f, 0xda, 0x40,
+	0x14, 0xc7, 0x6d, 0x2a, 0x31, 0xb8, 0x85, 0x22, 0xab, 0x45, 0x80, 0xd4, 0x73, 0x6b, 0x75, 0x60,
+	0xa8, 0xee, 0x44, 0xbb, 0x75, 0xb4, 0x22, 0x65, 0xc8, 0x10, 0x44, 0x32, 0x65, 0x21, 0x67, 0x73,
+	0xbe, 0x9c, 0xc4, 0xf9, 0x59, 0xe7, 0x73, 0x12, 0xbe, 0x45, 0xc6, 0xcc, 0xf9, 0x34, 0x8c, 0x8c,
+	0x99, 0x9c, 0x08, 0xb6, 0x8c, 0x7c, 0x82, 0x08, 0x9f, 0x21, 0x28, 0x89, 0x94, 0xcd, 0x7e, 0xf7,
+	0x7b, 0xef, 0xf7, 0xfe, 0xd2, 0x73, 0x7e, 0x73, 0x2a, 0x28, 0x49, 0x63, 0x19, 0x41, 0x12, 0x0b,
+	0x4e, 0x2e, 0x07, 0x21, 0xd3, 0x74, 0x40, 0x38, 0x4b, 0x58, 0x26, 0x32, 0x9c, 0x2a, 0xd0, 0xe0,
+	0xb6, 0x37, 0x14, 0xde, 0x51, 0xb8, 0xa2, 0x7a, 0xdf, 0x38, 0x70, 0x28, 0x11, 0xb2, 0xf9, 0x32,
+	0x74, 0x0f, 0x71, 0x00, 0x3e, 0x65, 0xa4, 0xfc, 0x0b, 0xf3, 0x98, 0x4c, 0x72, 0x45, 0xb5, 0x80,
+	0xc4, 0xbc, 0xfb, 0xe7, 0xce, 0x97, 0x43, 0x33, 0xfe, 0x44, 0x53, 0xcd, 0xdc, 0xa1, 0x53, 0x4f,
+	0xa9, 0xa2, 0x32, 0xeb, 0xd8, 0x3f, 0xed, 0xfe, 0xe7, 0xbf, 0x08, 0xbf, 0xaf, 0xc3, 0xc3, 0x92,
+	0x0a, 0x3a, 0xf3, 0xc2, 0xb3, 0x9e, 0x0a, 0xaf, 0x65, 0xba, 0xfe, 0x80, 0x14, 0x9a, 0xc9, 0x54,
+	0xcf, 0x46, 0xd5, 0x1c, 0xff, 0xae, 0xe6, 0xd4, 0x0d, 0xec, 0x1e, 0x39, 0xae, 0x62, 0x5a, 0x09,
+	0x96, 0x8d, 0x21, 0x19, 0x6b, 0x21, 0x19, 0xe4, 0xba, 0x14, 0x35, 0x82, 0x1f, 0xeb, 0xc2, 0xeb,
+	0xce, 0xa8, 0x9c, 0xfe, 0xf7, 0xdf, 0x32, 0xfe, 0xa8, 0x55, 0x15, 0x8f, 0x93, 0x53, 0x53, 0x72,
+	0x63, 0xe7, 0x6b, 0x0c, 0xea, 0x8a, 0xaa, 0xc9, 0x6e, 0x52, 0xad, 0x5c, 0xb9, 0x8b, 0x4d, 0x66,
+	0xbc, 0xcd, 0x8c, 0x0f, 0xaa, 0xcc, 0x81, 0xbf, 0xd9, 0x76, 0x5d, 0x78, 0x6d, 0x23, 0x7a, 0xd5,
+	0xef, 0xdf, 0x3e, 0x78, 0xf6, 0xa8, 0x59, 0x55, 0xb7, 0x9e, 0xc8, 0x69, 0x2a, 0x16, 0xe7, 0xc9,
+	0x8b, 0xe6, 0xd3, 0x47, 0x9a, 0x5f, 0x95, 0xe6, 0xfb, 0x36, 0xcf, 0x7e, 0xbb, 0xb1, 0x34, 0x4c,
+	0xb1, 0x92, 0x04, 0xc1, 0x7c, 0x89, 0xec, 0xc5, 0x12, 0xd9, 0x8f, 0x4b, 0x64, 0xdf, 0xac, 0x90,
+	0xb5, 0x58, 0x21, 0xeb, 0x7e, 0x85, 0xac, 0xb3, 0x3e, 0x17, 0xfa, 0x22, 0x0f, 0x71, 0x04, 0x92,
+	0x44, 0x90, 0x49, 0xc8, 0x48, 0x79, 0x26, 0xd7, 0x7b, 0x87, 0xa2, 0x67, 0x29, 0xcb, 0xc2, 0x7a,
+	0xb9, 0xc8, 0xbf, 0xe7, 0x01, 0x00, 0xbf, 0x74, 0xba, 0x1f, 0x47, 0x02, 0x00, 0x00,
+}
+
+func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.RefundTimeout, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.RefundTimeout):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintGenesis(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x1a
+	n3, err3 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.ForwardTimeout, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.ForwardTimeout):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintGenesis(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x12
+	if m.RetriesOnTimeout != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.RetriesOnTimeout))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RetriesOnTimeout != 0 {
+		n += 1 + sovGenesis(uint64(m.RetriesOnTimeout))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.ForwardTimeout)
+	n += 1 + l + sovGenesis(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.RefundTimeout)
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetriesOnTimeout", wireType)
+			}
+			m.RetriesOnTimeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RetriesOnTimeout |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForwardTimeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.ForwardTimeout, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundTimeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.RefundTimeout, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipGenesis(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthGenesis
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupGenesis
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthGenesis
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthGenesis        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowGenesis          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupGenesis = fmt.Errorf("proto: unexpected end of group")
+)
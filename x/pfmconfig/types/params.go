@@ -0,0 +1,102 @@
+package types
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	pfmkeeper "github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v7/packetforward/keeper"
+
+	errorsmod "cosmossdk.io/errors"
+
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	gaiaerrors "github.com/cosmos/gaia/v17/types/errors"
+)
+
+var (
+	ParamStoreKeyRetriesOnTimeout = []byte("RetriesOnTimeout")
+	ParamStoreKeyForwardTimeout   = []byte("ForwardTimeout")
+	ParamStoreKeyRefundTimeout    = []byte("RefundTimeout")
+
+	// DefaultRetriesOnTimeout disables retries, i.e. a forwarded packet that
+	// timed out is refunded right away.
+	DefaultRetriesOnTimeout uint32 = 0
+	// DefaultForwardTimeout and DefaultRefundTimeout match the defaults of the
+	// packet forward middleware.
+	DefaultForwardTimeout = pfmkeeper.DefaultForwardTransferPacketTimeoutTimestamp
+	DefaultRefundTimeout  = pfmkeeper.DefaultRefundTransferPacketTimeoutTimestamp
+)
+
+// DefaultParams returns default parameters
+func DefaultParams() Params {
+	return Params{
+		RetriesOnTimeout: DefaultRetriesOnTimeout,
+		ForwardTimeout:   DefaultForwardTimeout,
+		RefundTimeout:    DefaultRefundTimeout,
+	}
+}
+
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// ValidateBasic performs basic validation.
+func (p Params) ValidateBasic() error {
+	if err := validateRetriesOnTimeout(p.RetriesOnTimeout); err != nil {
+		return err
+	}
+
+	if err := validateTimeout(p.ForwardTimeout); err != nil {
+		return errorsmod.Wrap(err, "forward timeout")
+	}
+
+	if err := validateTimeout(p.RefundTimeout); err != nil {
+		return errorsmod.Wrap(err, "refund timeout")
+	}
+
+	return nil
+}
+
+// ParamSetPairs returns the parameter set pairs.
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(
+			ParamStoreKeyRetriesOnTimeout, &p.RetriesOnTimeout, validateRetriesOnTimeout,
+		),
+		paramtypes.NewParamSetPair(
+			ParamStoreKeyForwardTimeout, &p.ForwardTimeout, validateTimeout,
+		),
+		paramtypes.NewParamSetPair(
+			ParamStoreKeyRefundTimeout, &p.RefundTimeout, validateTimeout,
+		),
+	}
+}
+
+// validateRetriesOnTimeout checks that the retries fit into the uint8
+// expected by the packet forward middleware.
+func validateRetriesOnTimeout(i interface{}) error {
+	v, ok := i.(uint32)
+	if !ok {
+		return errorsmod.Wrapf(gaiaerrors.ErrInvalidType, "type: %T, expected uint32", i)
+	}
+
+	if v > math.MaxUint8 {
+		return fmt.Errorf("retries on timeout must not exceed %d: %d", math.MaxUint8, v)
+	}
+
+	return nil
+}
+
+func validateTimeout(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return errorsmod.Wrapf(gaiaerrors.ErrInvalidType, "type: %T, expected time.Duration", i)
+	}
+
+	if v <= 0 {
+		return fmt.Errorf("timeout must be positive: %s", v)
+	}
+
+	return nil
+}
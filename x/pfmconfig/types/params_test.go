@@ -0,0 +1,61 @@
+package types
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDefaultParams(t *testing.T) {
+	p := DefaultParams()
+	require.EqualValues(t, DefaultRetriesOnTimeout, p.RetriesOnTimeout)
+	require.EqualValues(t, DefaultForwardTimeout, p.ForwardTimeout)
+	require.EqualValues(t, DefaultRefundTimeout, p.RefundTimeout)
+	require.NoError(t, p.ValidateBasic())
+}
+
+func TestParamsValidateBasic(t *testing.T) {
+	tests := map[string]struct {
+		params    Params
+		expectErr bool
+	}{
+		"default params, pass": {
+			DefaultParams(),
+			false,
+		},
+		"max uint8 retries, pass": {
+			Params{RetriesOnTimeout: math.MaxUint8, ForwardTimeout: time.Minute, RefundTimeout: time.Hour},
+			false,
+		},
+		"retries overflow uint8, fail": {
+			Params{RetriesOnTimeout: math.MaxUint8 + 1, ForwardTimeout: time.Minute, RefundTimeout: time.Hour},
+			true,
+		},
+		"zero forward timeout, fail": {
+			Params{RetriesOnTimeout: 1, RefundTimeout: time.Hour},
+			true,
+		},
+		"negative refund timeout, fail": {
+			Params{RetriesOnTimeout: 1, ForwardTimeout: time.Minute, RefundTimeout: -time.Hour},
+			true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := test.params.ValidateBasic()
+			if test.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func Test_validateTypes(t *testing.T) {
+	require.Error(t, validateRetriesOnTimeout(uint8(1)))
+	require.Error(t, validateTimeout(int64(time.Minute)))
+}
@@ -0,0 +1,9 @@
+package rewarddenoms
+
+import (
+	"github.com/cosmos/gaia/v17/x/rewarddenoms/types"
+)
+
+const (
+	ModuleName = types.ModuleName
+)
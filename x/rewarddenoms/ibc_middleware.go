@@ -0,0 +1,58 @@
+package rewarddenoms
+
+import (
+	porttypes "github.com/cosmos/ibc-go/v7/modules/core/05-port/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/gaia/v17/x/rewarddenoms/keeper"
+)
+
+var _ porttypes.IBCModule = IBCMiddleware{}
+
+// IBCMiddleware registers the consumer reward denoms allowed by the reward
+// denom rules once a transfer channel with a consumer chain is established.
+// All other callbacks are passed through to the wrapped application.
+type IBCMiddleware struct {
+	porttypes.IBCModule
+	keeper keeper.Keeper
+}
+
+// NewIBCMiddleware creates a new IBCMiddleware wrapping the given transfer application.
+func NewIBCMiddleware(app porttypes.IBCModule, k keeper.Keeper) IBCMiddleware {
+	return IBCMiddleware{
+		IBCModule: app,
+		keeper:    k,
+	}
+}
+
+// OnChanOpenAck implements the IBCModule interface. It is called when the
+// provider initiated the channel handshake.
+func (im IBCMiddleware) OnChanOpenAck(
+	ctx sdk.Context,
+	portID, channelID string,
+	counterpartyChannelID string,
+	counterpartyVersion string,
+) error {
+	if err := im.IBCModule.OnChanOpenAck(ctx, portID, channelID, counterpartyChannelID, counterpartyVersion); err != nil {
+		return err
+	}
+
+	im.keeper.RegisterChannelRewardDenoms(ctx, channelID)
+	return nil
+}
+
+// OnChanOpenConfirm implements the IBCModule interface. It is called when the
+// consumer initiated the channel handshake, as consumer chains do for their
+// reward transfer channel.
+func (im IBCMiddleware) OnChanOpenConfirm(
+	ctx sdk.Context,
+	portID, channelID string,
+) error {
+	if err := im.IBCModule.OnChanOpenConfirm(ctx, portID, channelID); err != nil {
+		return err
+	}
+
+	im.keeper.RegisterChannelRewardDenoms(ctx, channelID)
+	return nil
+}
@@ -0,0 +1,21 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/gaia/v17/x/rewarddenoms/types"
+)
+
+// InitGenesis initializes the reward denoms module state from the provided genesis state
+func (k Keeper) InitGenesis(ctx sdk.Context, state types.GenesisState) {
+	k.SetParams(ctx, state.Params)
+
+	for _, registered := range state.RegisteredDenoms {
+		k.SetRegisteredDenom(ctx, registered)
+	}
+}
+
+// ExportGenesis exports the reward denoms module state
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	return types.NewGenesisState(k.GetParams(ctx), k.GetAllRegisteredDenoms(ctx))
+}
@@ -0,0 +1,47 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/gaia/v17/x/rewarddenoms/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// Params returns the reward denom rules
+func (k Keeper) Params(stdCtx context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(stdCtx)
+
+	return &types.QueryParamsResponse{Params: k.GetParams(ctx)}, nil
+}
+
+// RewardDenoms returns every consumer reward denom registered on the provider
+// together with the reason it is allowed
+func (k Keeper) RewardDenoms(stdCtx context.Context, _ *types.QueryRewardDenomsRequest) (*types.QueryRewardDenomsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(stdCtx)
+
+	denoms := k.providerKeeper.GetAllConsumerRewardDenoms(ctx)
+	res := make([]types.RewardDenom, 0, len(denoms))
+	for _, denom := range denoms {
+		registered, found := k.GetRegisteredDenom(ctx, denom)
+		if !found {
+			res = append(res, types.RewardDenom{
+				Denom:  denom,
+				Reason: "registered by governance proposal or MsgRegisterConsumerRewardDenom",
+			})
+			continue
+		}
+
+		res = append(res, types.RewardDenom{
+			Denom: denom,
+			Reason: fmt.Sprintf("registered by the rule of consumer chain %s for denom %s received on channel %s",
+				registered.ChainId, registered.BaseDenom, registered.ChannelId),
+			Rule: &registered,
+		})
+	}
+
+	return &types.QueryRewardDenomsResponse{Denoms: res}, nil
+}
@@ -0,0 +1,174 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cometbft/cometbft/libs/log"
+
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/gaia/v17/x/rewarddenoms/types"
+)
+
+// Keeper registers the consumer reward denoms allowed by the governance
+// approved rules once the consumer's transfer channel is established.
+type Keeper struct {
+	storeKey storetypes.StoreKey
+	cdc      codec.BinaryCodec
+
+	providerKeeper   types.ProviderKeeper
+	channelKeeper    types.ChannelKeeper
+	connectionKeeper types.ConnectionKeeper
+
+	// the address capable of executing a MsgUpdateParams message, typically
+	// the x/gov module account
+	authority string
+}
+
+// NewKeeper creates a new reward denoms Keeper instance
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	key storetypes.StoreKey,
+	providerKeeper types.ProviderKeeper,
+	channelKeeper types.ChannelKeeper,
+	connectionKeeper types.ConnectionKeeper,
+	authority string,
+) Keeper {
+	return Keeper{
+		storeKey:         key,
+		cdc:              cdc,
+		providerKeeper:   providerKeeper,
+		channelKeeper:    channelKeeper,
+		connectionKeeper: connectionKeeper,
+		authority:        authority,
+	}
+}
+
+// Logger returns the application logger, scoped to the associated module
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// GetAuthority returns the x/rewarddenoms module's authority
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// GetParams returns the reward denom rules
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ParamsKey)
+	if bz == nil {
+		return types.DefaultParams()
+	}
+
+	k.cdc.MustUnmarshal(bz, &params)
+	return params
+}
+
+// SetParams sets the reward denom rules
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ParamsKey, k.cdc.MustMarshal(&params))
+}
+
+// GetRegisteredDenom returns the rule registration of the given denom
+func (k Keeper) GetRegisteredDenom(ctx sdk.Context, denom string) (registered types.RegisteredDenom, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.RegisteredDenomKey(denom))
+	if bz == nil {
+		return registered, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &registered)
+	return registered, true
+}
+
+// SetRegisteredDenom records that the denom was registered by a rule
+func (k Keeper) SetRegisteredDenom(ctx sdk.Context, registered types.RegisteredDenom) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.RegisteredDenomKey(registered.Denom), k.cdc.MustMarshal(&registered))
+}
+
+// GetAllRegisteredDenoms returns every rule registration, sorted by denom
+func (k Keeper) GetAllRegisteredDenoms(ctx sdk.Context) (registered []types.RegisteredDenom) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.RegisteredDenomPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var r types.RegisteredDenom
+		k.cdc.MustUnmarshal(iterator.Value(), &r)
+		registered = append(registered, r)
+	}
+
+	return registered
+}
+
+// RegisterChannelRewardDenoms registers the reward denoms of the rule of the
+// consumer chain the given transfer channel is connected to. It returns the
+// denoms newly registered as consumer reward denoms; denoms that are already
+// registered, e.g. by a proposal, are left untouched.
+func (k Keeper) RegisterChannelRewardDenoms(ctx sdk.Context, channelID string) []types.RegisteredDenom {
+	channel, found := k.channelKeeper.GetChannel(ctx, transfertypes.PortID, channelID)
+	if !found {
+		return nil
+	}
+
+	params := k.GetParams(ctx)
+	for _, rule := range params.Rules {
+		if !k.isConsumerChannel(ctx, rule.ChainId, channel) {
+			continue
+		}
+
+		var registered []types.RegisteredDenom
+		for _, baseDenom := range rule.Denoms {
+			r := types.NewRegisteredDenom(rule.ChainId, transfertypes.PortID, channelID, baseDenom)
+			if k.providerKeeper.ConsumerRewardDenomExists(ctx, r.Denom) {
+				continue
+			}
+
+			k.providerKeeper.SetConsumerRewardDenom(ctx, r.Denom)
+			k.SetRegisteredDenom(ctx, r)
+			registered = append(registered, r)
+
+			k.Logger(ctx).Info("registered consumer reward denom", "denom", r.Denom, "chain", rule.ChainId, "channel", channelID, "base denom", baseDenom)
+		}
+
+		// a channel is built on a single connection and thus matches one rule at most
+		return registered
+	}
+
+	return nil
+}
+
+// RegisterOpenChannelsRewardDenoms applies the rules to every open transfer
+// channel and returns the denoms newly registered.
+func (k Keeper) RegisterOpenChannelsRewardDenoms(ctx sdk.Context) (registered []types.RegisteredDenom) {
+	for _, channel := range k.channelKeeper.GetAllChannelsWithPortPrefix(ctx, transfertypes.PortID) {
+		// the port prefix may also match other ports, e.g. "transfer-foo"
+		if channel.PortId != transfertypes.PortID || channel.State != channeltypes.OPEN {
+			continue
+		}
+
+		registered = append(registered, k.RegisterChannelRewardDenoms(ctx, channel.ChannelId)...)
+	}
+
+	return registered
+}
+
+// isConsumerChannel returns true if the channel is built on a connection to
+// the client of the given consumer chain.
+func (k Keeper) isConsumerChannel(ctx sdk.Context, chainID string, channel channeltypes.Channel) bool {
+	clientID, found := k.providerKeeper.GetConsumerClientId(ctx, chainID)
+	if !found || len(channel.ConnectionHops) != 1 {
+		return false
+	}
+
+	connection, found := k.connectionKeeper.GetConnection(ctx, channel.ConnectionHops[0])
+	return found && connection.ClientId == clientID
+}
@@ -0,0 +1,162 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	connectiontypes "github.com/cosmos/ibc-go/v7/modules/core/03-connection/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v7/modules/core/23-commitment/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	gaiaapp "github.com/cosmos/gaia/v17/app"
+	"github.com/cosmos/gaia/v17/app/helpers"
+	gaiaerrors "github.com/cosmos/gaia/v17/types/errors"
+	"github.com/cosmos/gaia/v17/x/rewarddenoms/keeper"
+	"github.com/cosmos/gaia/v17/x/rewarddenoms/types"
+)
+
+const (
+	consumerChainID = "consumer-1"
+	otherChainID    = "other-1"
+)
+
+// setupConsumerChannel registers chainID as consumer chain on its own client
+// and connection and opens a transfer channel with the given id and state on it.
+func setupConsumerChannel(t *testing.T, gaiaApp *gaiaapp.GaiaApp, ctx sdk.Context, chainID, channelID string, state channeltypes.State) {
+	t.Helper()
+
+	clientID := "07-tendermint-" + chainID
+	connectionID := "connection-" + chainID
+
+	gaiaApp.ProviderKeeper.SetConsumerClientId(ctx, chainID, clientID)
+	gaiaApp.IBCKeeper.ConnectionKeeper.SetConnection(ctx, connectionID, connectiontypes.NewConnectionEnd(
+		connectiontypes.OPEN, clientID,
+		connectiontypes.NewCounterparty("07-tendermint-0", "connection-0", commitmenttypes.NewMerklePrefix([]byte("ibc"))),
+		connectiontypes.ExportedVersionsToProto(connectiontypes.GetCompatibleVersions()), 0,
+	))
+	gaiaApp.IBCKeeper.ChannelKeeper.SetChannel(ctx, transfertypes.PortID, channelID, channeltypes.NewChannel(
+		state, channeltypes.UNORDERED,
+		channeltypes.NewCounterparty(transfertypes.PortID, "channel-1"),
+		[]string{connectionID}, transfertypes.Version,
+	))
+}
+
+func TestRegisterOnChannelOpen(t *testing.T) {
+	gaiaApp := helpers.Setup(t)
+	ctx := gaiaApp.NewUncachedContext(true, tmproto.Header{})
+
+	gaiaApp.RewardDenomsKeeper.SetParams(ctx, types.NewParams(
+		types.RewardDenomRule{ChainId: consumerChainID, Denoms: []string{"untrn", "transfer/channel-2/uosmo"}},
+	))
+	setupConsumerChannel(t, gaiaApp, ctx, consumerChainID, "channel-7", channeltypes.TRYOPEN)
+	setupConsumerChannel(t, gaiaApp, ctx, otherChainID, "channel-8", channeltypes.TRYOPEN)
+
+	// the consumer initiates the handshake of its transfer channel
+	transferStack, ok := gaiaApp.IBCKeeper.Router.GetRoute(transfertypes.ModuleName)
+	require.True(t, ok)
+	require.NoError(t, transferStack.OnChanOpenConfirm(ctx, transfertypes.PortID, "channel-7"))
+	require.NoError(t, transferStack.OnChanOpenConfirm(ctx, transfertypes.PortID, "channel-8"))
+
+	expected := []types.RegisteredDenom{
+		types.NewRegisteredDenom(consumerChainID, transfertypes.PortID, "channel-7", "untrn"),
+		types.NewRegisteredDenom(consumerChainID, transfertypes.PortID, "channel-7", "transfer/channel-2/uosmo"),
+	}
+	for _, registered := range expected {
+		require.True(t, gaiaApp.ProviderKeeper.ConsumerRewardDenomExists(ctx, registered.Denom))
+	}
+	require.ElementsMatch(t, expected, gaiaApp.RewardDenomsKeeper.GetAllRegisteredDenoms(ctx))
+	require.Equal(t, transfertypes.ParseDenomTrace("transfer/channel-7/untrn").IBCDenom(), expected[0].Denom)
+	require.Len(t, gaiaApp.ProviderKeeper.GetAllConsumerRewardDenoms(ctx), 2)
+}
+
+func TestRewardDenomsQuery(t *testing.T) {
+	gaiaApp := helpers.Setup(t)
+	ctx := gaiaApp.NewUncachedContext(true, tmproto.Header{})
+
+	manualDenom := transfertypes.ParseDenomTrace("transfer/channel-3/ustride").IBCDenom()
+	gaiaApp.ProviderKeeper.SetConsumerRewardDenom(ctx, manualDenom)
+
+	gaiaApp.RewardDenomsKeeper.SetParams(ctx, types.NewParams(
+		types.RewardDenomRule{ChainId: consumerChainID, Denoms: []string{"untrn"}},
+	))
+	setupConsumerChannel(t, gaiaApp, ctx, consumerChainID, "channel-7", channeltypes.OPEN)
+	registered := gaiaApp.RewardDenomsKeeper.RegisterChannelRewardDenoms(ctx, "channel-7")
+	require.Len(t, registered, 1)
+
+	res, err := gaiaApp.RewardDenomsKeeper.RewardDenoms(sdk.WrapSDKContext(ctx), &types.QueryRewardDenomsRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.Denoms, 2)
+	for _, denom := range res.Denoms {
+		switch denom.Denom {
+		case manualDenom:
+			require.Nil(t, denom.Rule)
+			require.Contains(t, denom.Reason, "governance proposal")
+		case registered[0].Denom:
+			require.Equal(t, &registered[0], denom.Rule)
+			require.Contains(t, denom.Reason, "consumer chain consumer-1 for denom untrn received on channel channel-7")
+		default:
+			t.Fatalf("unexpected denom %s", denom.Denom)
+		}
+	}
+}
+
+func TestUpdateParams(t *testing.T) {
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	params := types.NewParams(types.RewardDenomRule{ChainId: consumerChainID, Denoms: []string{"untrn"}})
+
+	testCases := []struct {
+		name          string
+		msg           *types.MsgUpdateParams
+		expErr        error
+		expRegistered []types.RegisteredDenom
+	}{
+		{
+			name: "rule applied to open channels",
+			msg:  types.NewMsgUpdateParams(authority, params),
+			expRegistered: []types.RegisteredDenom{
+				types.NewRegisteredDenom(consumerChainID, transfertypes.PortID, "channel-7", "untrn"),
+			},
+		},
+		{
+			name:          "no rules",
+			msg:           types.NewMsgUpdateParams(authority, types.DefaultParams()),
+			expRegistered: nil,
+		},
+		{
+			name:   "invalid authority",
+			msg:    types.NewMsgUpdateParams(sdk.AccAddress("unauthorized").String(), params),
+			expErr: gaiaerrors.ErrUnauthorized,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			gaiaApp := helpers.Setup(t)
+			ctx := gaiaApp.NewUncachedContext(true, tmproto.Header{})
+
+			setupConsumerChannel(t, gaiaApp, ctx, consumerChainID, "channel-7", channeltypes.OPEN)
+			// channels that are not open yet are registered once the handshake completes
+			setupConsumerChannel(t, gaiaApp, ctx, consumerChainID, "channel-8", channeltypes.TRYOPEN)
+
+			res, err := keeper.NewMsgServerImpl(gaiaApp.RewardDenomsKeeper).UpdateParams(sdk.WrapSDKContext(ctx), tc.msg)
+			if tc.expErr != nil {
+				require.ErrorIs(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expRegistered, res.Registered)
+			require.Equal(t, tc.msg.Params, gaiaApp.RewardDenomsKeeper.GetParams(ctx))
+
+			// applying the rules again does not register anything new
+			require.Empty(t, gaiaApp.RewardDenomsKeeper.RegisterOpenChannelsRewardDenoms(ctx))
+		})
+	}
+}
@@ -0,0 +1,27 @@
+package types
+
+import (
+	connectiontypes "github.com/cosmos/ibc-go/v7/modules/core/03-connection/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ProviderKeeper defines the expected ICS provider keeper
+type ProviderKeeper interface {
+	GetConsumerClientId(ctx sdk.Context, chainID string) (string, bool)
+	SetConsumerRewardDenom(ctx sdk.Context, denom string)
+	ConsumerRewardDenomExists(ctx sdk.Context, denom string) bool
+	GetAllConsumerRewardDenoms(ctx sdk.Context) []string
+}
+
+// ChannelKeeper defines the expected IBC channel keeper
+type ChannelKeeper interface {
+	GetChannel(ctx sdk.Context, srcPort, srcChan string) (channeltypes.Channel, bool)
+	GetAllChannelsWithPortPrefix(ctx sdk.Context, portPrefix string) []channeltypes.IdentifiedChannel
+}
+
+// ConnectionKeeper defines the expected IBC connection keeper
+type ConnectionKeeper interface {
+	GetConnection(ctx sdk.Context, connectionID string) (connectiontypes.ConnectionEnd, bool)
+}
@@ -0,0 +1,40 @@
+package types
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+)
+
+// NewGenesisState - Create a new genesis state
+func NewGenesisState(params Params, registered []RegisteredDenom) *GenesisState {
+	return &GenesisState{
+		Params:           params,
+		RegisteredDenoms: registered,
+	}
+}
+
+// DefaultGenesisState - Return a default genesis state
+func DefaultGenesisState() *GenesisState {
+	return NewGenesisState(DefaultParams(), nil)
+}
+
+func ValidateGenesis(data GenesisState) error {
+	if err := data.Params.ValidateBasic(); err != nil {
+		return errorsmod.Wrap(err, "rewarddenoms params")
+	}
+
+	denoms := make(map[string]struct{}, len(data.RegisteredDenoms))
+	for _, registered := range data.RegisteredDenoms {
+		if err := registered.ValidateBasic(); err != nil {
+			return errorsmod.Wrap(err, "rewarddenoms registered denom")
+		}
+
+		if _, ok := denoms[registered.Denom]; ok {
+			return fmt.Errorf("duplicate registered denom %s", registered.Denom)
+		}
+		denoms[registered.Denom] = struct{}{}
+	}
+
+	return nil
+}
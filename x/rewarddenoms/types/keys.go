@@ -0,0 +1,25 @@
+package types
+
+const (
+	// ModuleName is the name of the this module
+	ModuleName = "rewarddenoms"
+
+	// StoreKey is the store key string for the reward denoms module
+	StoreKey = ModuleName
+
+	// QuerierRoute is the querier route for the reward denoms module
+	QuerierRoute = ModuleName
+)
+
+var (
+	// ParamsKey defines the key to store the module params in store
+	ParamsKey = []byte{0x01}
+
+	// RegisteredDenomPrefix defines the prefix of the reward denoms registered by a rule
+	RegisteredDenomPrefix = []byte{0x02}
+)
+
+// RegisteredDenomKey returns the store key of the rule registration of the given denom
+func RegisteredDenomKey(denom string) []byte {
+	return append(RegisteredDenomPrefix, []byte(denom)...)
+}
@@ -0,0 +1,80 @@
+package types
+
+import (
+	"strings"
+
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+
+	errorsmod "cosmossdk.io/errors"
+
+	gaiaerrors "github.com/cosmos/gaia/v17/types/errors"
+)
+
+// NewParams creates a new Params instance
+func NewParams(rules ...RewardDenomRule) Params {
+	return Params{Rules: rules}
+}
+
+// DefaultParams returns the default params, no consumer reward denom is
+// registered automatically.
+func DefaultParams() Params {
+	return NewParams()
+}
+
+// ValidateBasic performs basic validation on the reward denom rules.
+func (p Params) ValidateBasic() error {
+	chainIDs := make(map[string]struct{}, len(p.Rules))
+	for _, rule := range p.Rules {
+		if err := rule.ValidateBasic(); err != nil {
+			return err
+		}
+
+		if _, ok := chainIDs[rule.ChainId]; ok {
+			return errorsmod.Wrapf(gaiaerrors.ErrInvalidType, "duplicate rule for consumer chain %s", rule.ChainId)
+		}
+		chainIDs[rule.ChainId] = struct{}{}
+	}
+
+	return nil
+}
+
+// GetRule returns the rule of the given consumer chain.
+func (p Params) GetRule(chainID string) (RewardDenomRule, bool) {
+	for _, rule := range p.Rules {
+		if rule.ChainId == chainID {
+			return rule, true
+		}
+	}
+
+	return RewardDenomRule{}, false
+}
+
+// ValidateBasic performs basic validation on a reward denom rule.
+func (r RewardDenomRule) ValidateBasic() error {
+	if strings.TrimSpace(r.ChainId) == "" {
+		return errorsmod.Wrap(gaiaerrors.ErrInvalidType, "consumer chain id cannot be blank")
+	}
+
+	if len(r.Denoms) == 0 {
+		return errorsmod.Wrapf(gaiaerrors.ErrInvalidType, "rule for consumer chain %s has no denoms", r.ChainId)
+	}
+
+	denoms := make(map[string]struct{}, len(r.Denoms))
+	for _, denom := range r.Denoms {
+		// the rule matches the denom as sent by the consumer chain, IBC vouchers
+		// must be given by their full trace since the hash is not known on receive
+		if strings.HasPrefix(denom, transfertypes.DenomPrefix+"/") {
+			return errorsmod.Wrapf(gaiaerrors.ErrInvalidType, "denom %s: use the full denom trace instead of the ibc denom", denom)
+		}
+		if err := transfertypes.ValidatePrefixedDenom(denom); err != nil {
+			return errorsmod.Wrapf(err, "rule for consumer chain %s", r.ChainId)
+		}
+
+		if _, ok := denoms[denom]; ok {
+			return errorsmod.Wrapf(gaiaerrors.ErrInvalidType, "duplicate denom %s in rule for consumer chain %s", denom, r.ChainId)
+		}
+		denoms[denom] = struct{}{}
+	}
+
+	return nil
+}
@@ -0,0 +1,72 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParamsValidateBasic(t *testing.T) {
+	tests := map[string]struct {
+		params    Params
+		expectErr bool
+	}{
+		"default params, pass": {
+			DefaultParams(),
+			false,
+		},
+		"base denom and denom trace, pass": {
+			NewParams(RewardDenomRule{ChainId: "consumer-1", Denoms: []string{"untrn", "transfer/channel-1/uatom"}}),
+			false,
+		},
+		"blank chain id, fail": {
+			NewParams(RewardDenomRule{ChainId: " ", Denoms: []string{"untrn"}}),
+			true,
+		},
+		"no denoms, fail": {
+			NewParams(RewardDenomRule{ChainId: "consumer-1"}),
+			true,
+		},
+		"ibc denom, fail": {
+			NewParams(RewardDenomRule{ChainId: "consumer-1", Denoms: []string{"ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"}}),
+			true,
+		},
+		"blank base denom, fail": {
+			NewParams(RewardDenomRule{ChainId: "consumer-1", Denoms: []string{"transfer/channel-1/"}}),
+			true,
+		},
+		"duplicate denom, fail": {
+			NewParams(RewardDenomRule{ChainId: "consumer-1", Denoms: []string{"untrn", "untrn"}}),
+			true,
+		},
+		"duplicate chain id, fail": {
+			NewParams(
+				RewardDenomRule{ChainId: "consumer-1", Denoms: []string{"untrn"}},
+				RewardDenomRule{ChainId: "consumer-1", Denoms: []string{"ustrd"}},
+			),
+			true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := test.params.ValidateBasic()
+			if test.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestValidateGenesis(t *testing.T) {
+	require.NoError(t, ValidateGenesis(*DefaultGenesisState()))
+
+	registered := NewRegisteredDenom("consumer-1", "transfer", "channel-7", "untrn")
+	require.NoError(t, ValidateGenesis(*NewGenesisState(DefaultParams(), []RegisteredDenom{registered})))
+	require.Error(t, ValidateGenesis(*NewGenesisState(DefaultParams(), []RegisteredDenom{registered, registered})))
+
+	registered.BaseDenom = "uatom"
+	require.Error(t, ValidateGenesis(*NewGenesisState(DefaultParams(), []RegisteredDenom{registered})))
+}
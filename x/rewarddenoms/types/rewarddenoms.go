@@ -0,0 +1,46 @@
+package types
+
+import (
+	"strings"
+
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
+
+	errorsmod "cosmossdk.io/errors"
+
+	gaiaerrors "github.com/cosmos/gaia/v17/types/errors"
+)
+
+// NewRegisteredDenom returns the registration of the reward denom received
+// from a consumer chain over the given provider transfer channel.
+func NewRegisteredDenom(chainID, portID, channelID, baseDenom string) RegisteredDenom {
+	return RegisteredDenom{
+		Denom:     IBCDenom(portID, channelID, baseDenom),
+		ChainId:   chainID,
+		ChannelId: channelID,
+		BaseDenom: baseDenom,
+	}
+}
+
+// IBCDenom returns the denom of the vouchers minted on the provider when
+// receiving the given denom over the given provider transfer channel.
+func IBCDenom(portID, channelID, baseDenom string) string {
+	return transfertypes.ParseDenomTrace(transfertypes.GetPrefixedDenom(portID, channelID, baseDenom)).IBCDenom()
+}
+
+// ValidateBasic checks that the registration is consistent.
+func (r RegisteredDenom) ValidateBasic() error {
+	if strings.TrimSpace(r.ChainId) == "" {
+		return errorsmod.Wrap(gaiaerrors.ErrInvalidType, "consumer chain id cannot be blank")
+	}
+
+	if err := host.ChannelIdentifierValidator(r.ChannelId); err != nil {
+		return err
+	}
+
+	if expected := IBCDenom(transfertypes.PortID, r.ChannelId, r.BaseDenom); r.Denom != expected {
+		return errorsmod.Wrapf(gaiaerrors.ErrInvalidType, "denom %s does not match base denom %s on channel %s, expected %s", r.Denom, r.BaseDenom, r.ChannelId, expected)
+	}
+
+	return nil
+}
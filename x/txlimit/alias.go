@@ -0,0 +1,9 @@
+package txlimit
+
+import (
+	"github.com/cosmos/gaia/v17/x/txlimit/types"
+)
+
+const (
+	ModuleName = types.ModuleName
+)
@@ -0,0 +1,38 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/gaia/v17/x/txlimit/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// Params returns the tx limit params
+func (k Keeper) Params(stdCtx context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(stdCtx)
+
+	return &types.QueryParamsResponse{Params: k.GetParams(ctx)}, nil
+}
+
+// AccountUsage returns the usage of the account in the current window
+func (k Keeper) AccountUsage(stdCtx context.Context, req *types.QueryAccountUsageRequest) (*types.QueryAccountUsageResponse, error) {
+	ctx := sdk.UnwrapSDKContext(stdCtx)
+
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "address")
+	}
+
+	params := k.GetParams(ctx)
+	windowStartHeight := params.WindowStartHeight(ctx.BlockHeight())
+	return &types.QueryAccountUsageResponse{
+		WindowStartHeight: windowStartHeight,
+		Usage:             k.GetWindowUsage(ctx, windowStartHeight, addr),
+		Exempt:            params.IsExempt(req.Address),
+	}, nil
+}
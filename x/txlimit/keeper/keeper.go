@@ -0,0 +1,181 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cometbft/cometbft/libs/log"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	gaiaerrors "github.com/cosmos/gaia/v17/types/errors"
+	"github.com/cosmos/gaia/v17/x/txlimit/types"
+)
+
+// Keeper stores the limits of the txs and rate limits the txs of the
+// accounts. The usage of the accounts in the current block is kept in the
+// transient store, and added to their usage in the window of blocks at the
+// end of the block. The windows are pruned once over.
+type Keeper struct {
+	storeKey  storetypes.StoreKey
+	tStoreKey storetypes.StoreKey
+	cdc       codec.BinaryCodec
+
+	// the address capable of executing a MsgUpdateParams message, typically
+	// the x/gov module account
+	authority string
+}
+
+// NewKeeper creates a new tx limit Keeper instance
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	key storetypes.StoreKey,
+	tKey storetypes.StoreKey,
+	authority string,
+) Keeper {
+	return Keeper{
+		storeKey:  key,
+		tStoreKey: tKey,
+		cdc:       cdc,
+		authority: authority,
+	}
+}
+
+// Logger returns the application logger, scoped to the associated module
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// GetAuthority returns the x/txlimit module's authority
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// GetParams returns the tx limit params
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ParamsKey)
+	if bz == nil {
+		return types.DefaultParams()
+	}
+
+	k.cdc.MustUnmarshal(bz, &params)
+	return params
+}
+
+// SetParams sets the tx limit params
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ParamsKey, k.cdc.MustMarshal(&params))
+}
+
+// GetWindowUsage returns the usage of the account in the committed blocks of
+// the window starting at the given height
+func (k Keeper) GetWindowUsage(ctx sdk.Context, windowStartHeight int64, addr sdk.AccAddress) (usage types.AccountUsage) {
+	bz := ctx.KVStore(k.storeKey).Get(types.WindowUsageKey(windowStartHeight, addr))
+	if bz != nil {
+		k.cdc.MustUnmarshal(bz, &usage)
+	}
+
+	return usage
+}
+
+// SetWindowUsage sets the usage of the account in the window starting at the
+// given height
+func (k Keeper) SetWindowUsage(ctx sdk.Context, windowStartHeight int64, addr sdk.AccAddress, usage types.AccountUsage) {
+	ctx.KVStore(k.storeKey).Set(types.WindowUsageKey(windowStartHeight, addr), k.cdc.MustMarshal(&usage))
+}
+
+// GetBlockUsage returns the usage of the account in the current block
+func (k Keeper) GetBlockUsage(ctx sdk.Context, addr sdk.AccAddress) (usage types.AccountUsage) {
+	bz := ctx.TransientStore(k.tStoreKey).Get(types.BlockUsageKey(addr))
+	if bz != nil {
+		k.cdc.MustUnmarshal(bz, &usage)
+	}
+
+	return usage
+}
+
+// GetAccountUsage returns the usage of the account in the current window,
+// including the current block
+func (k Keeper) GetAccountUsage(ctx sdk.Context, addr sdk.AccAddress) types.AccountUsage {
+	windowStartHeight := k.GetParams(ctx).WindowStartHeight(ctx.BlockHeight())
+	return k.GetWindowUsage(ctx, windowStartHeight, addr).Add(k.GetBlockUsage(ctx, addr))
+}
+
+// ConsumeAccountUsage adds a tx with the given gas limit to the usage of the
+// account in the current block. It returns an error if the account exceeds
+// the txs or gas limit of the window, unless the account is exempt.
+func (k Keeper) ConsumeAccountUsage(ctx sdk.Context, addr sdk.AccAddress, gas uint64) error {
+	params := k.GetParams(ctx)
+	if !params.RateLimitEnabled() || params.IsExempt(addr.String()) {
+		return nil
+	}
+
+	usage := k.GetAccountUsage(ctx, addr).Add(types.AccountUsage{Txs: 1, Gas: gas})
+	if params.MaxTxsPerWindow > 0 && usage.Txs > params.MaxTxsPerWindow {
+		return errorsmod.Wrapf(gaiaerrors.ErrTxRateLimited, "account %s exceeds %d txs per %d blocks", addr, params.MaxTxsPerWindow, params.WindowBlocks)
+	}
+	if params.MaxGasPerWindow > 0 && usage.Gas > params.MaxGasPerWindow {
+		return errorsmod.Wrapf(gaiaerrors.ErrTxRateLimited, "account %s exceeds %d gas per %d blocks", addr, params.MaxGasPerWindow, params.WindowBlocks)
+	}
+
+	blockUsage := k.GetBlockUsage(ctx, addr).Add(types.AccountUsage{Txs: 1, Gas: gas})
+	ctx.TransientStore(k.tStoreKey).Set(types.BlockUsageKey(addr), k.cdc.MustMarshal(&blockUsage))
+
+	return nil
+}
+
+// CommitBlockUsage adds the usage of the accounts in the current block to
+// their usage in the current window.
+func (k Keeper) CommitBlockUsage(ctx sdk.Context) {
+	params := k.GetParams(ctx)
+	if !params.RateLimitEnabled() {
+		return
+	}
+	windowStartHeight := params.WindowStartHeight(ctx.BlockHeight())
+
+	iterator := sdk.KVStorePrefixIterator(ctx.TransientStore(k.tStoreKey), types.BlockUsagePrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		// the key is the prefix followed by the length prefixed address
+		addr := sdk.AccAddress(iterator.Key()[len(types.BlockUsagePrefix)+1:])
+
+		var blockUsage types.AccountUsage
+		k.cdc.MustUnmarshal(iterator.Value(), &blockUsage)
+		k.SetWindowUsage(ctx, windowStartHeight, addr, k.GetWindowUsage(ctx, windowStartHeight, addr).Add(blockUsage))
+	}
+}
+
+// PruneWindowUsages deletes the usages of the windows before the current
+// one.
+func (k Keeper) PruneWindowUsages(ctx sdk.Context) {
+	windowStartHeight := k.GetParams(ctx).WindowStartHeight(ctx.BlockHeight())
+	k.deleteWindowUsages(ctx, types.WindowUsagePrefixKey(windowStartHeight))
+}
+
+// ClearWindowUsages deletes the usages of every window, e.g. when the window
+// size changes.
+func (k Keeper) ClearWindowUsages(ctx sdk.Context) {
+	k.deleteWindowUsages(ctx, storetypes.PrefixEndBytes(types.WindowUsagePrefix))
+}
+
+// deleteWindowUsages deletes the usages stored before the end key.
+func (k Keeper) deleteWindowUsages(ctx sdk.Context, end []byte) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(types.WindowUsagePrefix, end)
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
@@ -0,0 +1,108 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/cosmos/gaia/v17/app/helpers"
+	gaiaerrors "github.com/cosmos/gaia/v17/types/errors"
+	"github.com/cosmos/gaia/v17/x/txlimit/keeper"
+	"github.com/cosmos/gaia/v17/x/txlimit/types"
+)
+
+var (
+	govAuthority = authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	account      = sdk.AccAddress("account_____________")
+	relayer      = sdk.AccAddress("relayer_____________")
+)
+
+func TestConsumeAccountUsage(t *testing.T) {
+	gaiaApp := helpers.Setup(t)
+	ctx := gaiaApp.NewUncachedContext(false, tmproto.Header{Height: 10})
+	k := gaiaApp.TxLimitKeeper
+
+	// the accounts are not rate limited by default
+	for i := 0; i < 10; i++ {
+		require.NoError(t, k.ConsumeAccountUsage(ctx, account, 1_000_000))
+	}
+	require.True(t, k.GetAccountUsage(ctx, account).IsZero())
+
+	// 3 txs and 300_000 gas per window of 10 blocks
+	k.SetParams(ctx, types.NewParams(10, 3, 300_000, []string{relayer.String()}, 0, 0, 0))
+	require.NoError(t, k.ConsumeAccountUsage(ctx, account, 100_000))
+	require.NoError(t, k.ConsumeAccountUsage(ctx, account, 150_000))
+	err := k.ConsumeAccountUsage(ctx, account, 100_000)
+	require.ErrorIs(t, err, gaiaerrors.ErrTxRateLimited)
+	require.ErrorContains(t, err, "exceeds 300000 gas per 10 blocks")
+	require.Equal(t, types.AccountUsage{Txs: 2, Gas: 250_000}, k.GetAccountUsage(ctx, account))
+
+	// the usage of the block is added to the usage of the window
+	k.CommitBlockUsage(ctx)
+	require.Equal(t, types.AccountUsage{Txs: 2, Gas: 250_000}, k.GetWindowUsage(ctx, 10, account))
+	// the transient store is reset on commit
+	gaiaApp.CommitMultiStore().Commit()
+
+	ctx = gaiaApp.NewUncachedContext(false, tmproto.Header{Height: 19})
+	require.True(t, k.GetBlockUsage(ctx, account).IsZero())
+	require.NoError(t, k.ConsumeAccountUsage(ctx, account, 50_000))
+	err = k.ConsumeAccountUsage(ctx, account, 0)
+	require.ErrorIs(t, err, gaiaerrors.ErrTxRateLimited)
+	require.ErrorContains(t, err, "exceeds 3 txs per 10 blocks")
+
+	// the exempt accounts are not rate limited
+	for i := 0; i < 10; i++ {
+		require.NoError(t, k.ConsumeAccountUsage(ctx, relayer, 1_000_000))
+	}
+	require.True(t, k.GetAccountUsage(ctx, relayer).IsZero())
+	k.CommitBlockUsage(ctx)
+	gaiaApp.CommitMultiStore().Commit()
+
+	// the next window starts at height 20, the previous one is pruned
+	ctx = gaiaApp.NewUncachedContext(false, tmproto.Header{Height: 20})
+	require.True(t, k.GetAccountUsage(ctx, account).IsZero())
+	k.PruneWindowUsages(ctx)
+	require.True(t, k.GetWindowUsage(ctx, 10, account).IsZero())
+	require.NoError(t, k.ConsumeAccountUsage(ctx, account, 300_000))
+}
+
+func TestUpdateParams(t *testing.T) {
+	gaiaApp := helpers.Setup(t)
+	ctx := gaiaApp.NewUncachedContext(false, tmproto.Header{Height: 10})
+	k := gaiaApp.TxLimitKeeper
+	msgServer := keeper.NewMsgServerImpl(k)
+
+	params := types.NewParams(10, 3, 0, nil, 0, 0, 0)
+	_, err := msgServer.UpdateParams(ctx, types.NewMsgUpdateParams(account.String(), params))
+	require.ErrorIs(t, err, gaiaerrors.ErrUnauthorized)
+	_, err = msgServer.UpdateParams(ctx, types.NewMsgUpdateParams(govAuthority, types.NewParams(0, 3, 0, nil, 0, 0, 0)))
+	require.ErrorIs(t, err, gaiaerrors.ErrInvalidType)
+
+	_, err = msgServer.UpdateParams(ctx, types.NewMsgUpdateParams(govAuthority, params))
+	require.NoError(t, err)
+	require.Equal(t, params, k.GetParams(ctx))
+
+	require.NoError(t, k.ConsumeAccountUsage(ctx, account, 100))
+	k.CommitBlockUsage(ctx)
+
+	// the usages are kept if the window size does not change
+	_, err = msgServer.UpdateParams(ctx, types.NewMsgUpdateParams(govAuthority, types.NewParams(10, 5, 0, nil, 0, 0, 0)))
+	require.NoError(t, err)
+	res, err := k.AccountUsage(ctx, &types.QueryAccountUsageRequest{Address: account.String()})
+	require.NoError(t, err)
+	require.Equal(t, &types.QueryAccountUsageResponse{
+		WindowStartHeight: 10,
+		Usage:             types.AccountUsage{Txs: 1, Gas: 100},
+	}, res)
+
+	// and reset otherwise
+	_, err = msgServer.UpdateParams(ctx, types.NewMsgUpdateParams(govAuthority, types.NewParams(4, 5, 0, nil, 0, 0, 0)))
+	require.NoError(t, err)
+	require.True(t, k.GetWindowUsage(ctx, 10, account).IsZero())
+}
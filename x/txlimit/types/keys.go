@@ -0,0 +1,53 @@
+package types
+
+import (
+	"encoding/binary"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+const (
+	// ModuleName is the name of the this module
+	ModuleName = "txlimit"
+
+	// StoreKey is the store key string for the tx limit module
+	StoreKey = ModuleName
+
+	// TStoreKey is the transient store key string for the tx limit module
+	TStoreKey = "transient_" + ModuleName
+
+	// QuerierRoute is the querier route for the tx limit module
+	QuerierRoute = ModuleName
+)
+
+var (
+	// ParamsKey defines the key to store the module params in store
+	ParamsKey = []byte{0x01}
+
+	// WindowUsagePrefix defines the prefix of the usage of the accounts in
+	// the committed blocks of a rate limit window
+	WindowUsagePrefix = []byte{0x02}
+
+	// BlockUsagePrefix defines the prefix of the usage of the accounts in the
+	// current block, in the transient store
+	BlockUsagePrefix = []byte{0x01}
+)
+
+// WindowUsagePrefixKey returns the store prefix of the usages in the window
+// starting at the given height
+func WindowUsagePrefixKey(windowStartHeight int64) []byte {
+	return binary.BigEndian.AppendUint64(append([]byte{}, WindowUsagePrefix...), uint64(windowStartHeight))
+}
+
+// WindowUsageKey returns the store key of the usage of the account in the
+// window starting at the given height
+func WindowUsageKey(windowStartHeight int64, addr sdk.AccAddress) []byte {
+	return append(WindowUsagePrefixKey(windowStartHeight), address.MustLengthPrefix(addr)...)
+}
+
+// BlockUsageKey returns the transient store key of the usage of the account
+// in the current block
+func BlockUsageKey(addr sdk.AccAddress) []byte {
+	return append(append([]byte{}, BlockUsagePrefix...), address.MustLengthPrefix(addr)...)
+}
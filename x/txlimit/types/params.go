@@ -0,0 +1,81 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	gaiaerrors "github.com/cosmos/gaia/v17/types/errors"
+)
+
+// NewParams creates a new Params instance
+func NewParams(
+	windowBlocks, maxTxsPerWindow, maxGasPerWindow uint64, exemptAddresses []string,
+	maxTxGas, maxMsgsPerTx, maxNestedDepth uint64,
+) Params {
+	return Params{
+		WindowBlocks:    windowBlocks,
+		MaxTxsPerWindow: maxTxsPerWindow,
+		MaxGasPerWindow: maxGasPerWindow,
+		ExemptAddresses: exemptAddresses,
+		MaxTxGas:        maxTxGas,
+		MaxMsgsPerTx:    maxMsgsPerTx,
+		MaxNestedDepth:  maxNestedDepth,
+	}
+}
+
+// DefaultParams returns the default params, the accounts are not rate
+// limited and the txs are not limited.
+func DefaultParams() Params {
+	return NewParams(0, 0, 0, nil, 0, 0, 0)
+}
+
+// ValidateBasic performs basic validation on the tx limit params.
+func (p Params) ValidateBasic() error {
+	if p.WindowBlocks == 0 && (p.MaxTxsPerWindow != 0 || p.MaxGasPerWindow != 0) {
+		return errorsmod.Wrap(gaiaerrors.ErrInvalidType, "window blocks must be positive to rate limit the accounts")
+	}
+	if p.WindowBlocks != 0 && p.MaxTxsPerWindow == 0 && p.MaxGasPerWindow == 0 {
+		return errorsmod.Wrap(gaiaerrors.ErrInvalidType, "max txs or max gas per window must be positive to rate limit the accounts")
+	}
+
+	exempt := make(map[string]struct{}, len(p.ExemptAddresses))
+	for _, addr := range p.ExemptAddresses {
+		if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+			return errorsmod.Wrapf(err, "exempt address %s", addr)
+		}
+
+		if _, ok := exempt[addr]; ok {
+			return errorsmod.Wrapf(gaiaerrors.ErrInvalidType, "duplicate exempt address %s", addr)
+		}
+		exempt[addr] = struct{}{}
+	}
+
+	return nil
+}
+
+// RateLimitEnabled returns true if the accounts are rate limited.
+func (p Params) RateLimitEnabled() bool {
+	return p.WindowBlocks > 0
+}
+
+// WindowStartHeight returns the first height of the rate limit window of the
+// given height.
+func (p Params) WindowStartHeight(height int64) int64 {
+	if p.WindowBlocks == 0 || height <= 0 {
+		return height
+	}
+
+	return height - height%int64(p.WindowBlocks)
+}
+
+// IsExempt returns true if the account is not rate limited.
+func (p Params) IsExempt(address string) bool {
+	for _, exempt := range p.ExemptAddresses {
+		if exempt == address {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,753 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: gaia/txlimit/v1beta1/txlimit.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params defines the limits of each tx, and of the txs of each account over a
+// window of blocks.
+type Params struct {
+	// window_blocks is the number of blocks of a rate limit window, 0 disables
+	// the rate limiting.
+	WindowBlocks uint64 `protobuf:"varint,1,opt,name=window_blocks,json=windowBlocks,proto3" json:"window_blocks,omitempty"`
+	// max_txs_per_window is the maximum number of txs paid by an account in a
+	// window, 0 is no limit.
+	MaxTxsPerWindow uint64 `protobuf:"varint,2,opt,name=max_txs_per_window,json=maxTxsPerWindow,proto3" json:"max_txs_per_window,omitempty"`
+	// max_gas_per_window is the maximum total gas limit of the txs paid by an
+	// account in a window, 0 is no limit.
+	MaxGasPerWindow uint64 `protobuf:"varint,3,opt,name=max_gas_per_window,json=maxGasPerWindow,proto3" json:"max_gas_per_window,omitempty"`
+	// exempt_addresses are the accounts, e.g. module accounts and relayers,
+	// that are not rate limited.
+	ExemptAddresses []string `protobuf:"bytes,4,rep,name=exempt_addresses,json=exemptAddresses,proto3" json:"exempt_addresses,omitempty"`
+	// max_tx_gas is the maximum gas limit of a tx.
+	MaxTxGas uint64 `protobuf:"varint,5,opt,name=max_tx_gas,json=maxTxGas,proto3" json:"max_tx_gas,omitempty"`
+	// max_msgs_per_tx is the maximum number of msgs of a tx, counting the msgs
+	// executed by an authz MsgExec or sent to an interchain account along with
+	// the msgs that wrap them.
+	MaxMsgsPerTx uint64 `protobuf:"varint,6,opt,name=max_msgs_per_tx,json=maxMsgsPerTx,proto3" json:"max_msgs_per_tx,omitempty"`
+	// max_nested_depth is the maximum depth of the msgs nested in an authz
+	// MsgExec or in the payload of an interchain account tx, the msgs of the
+	// tx being at depth 0.
+	MaxNestedDepth uint64 `protobuf:"varint,7,opt,name=max_nested_depth,json=maxNestedDepth,proto3" json:"max_nested_depth,omitempty"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2ef1f027b198b6d4, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetWindowBlocks() uint64 {
+	if m != nil {
+		return m.WindowBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetMaxTxsPerWindow() uint64 {
+	if m != nil {
+		return m.MaxTxsPerWindow
+	}
+	return 0
+}
+
+func (m *Params) GetMaxGasPerWindow() uint64 {
+	if m != nil {
+		return m.MaxGasPerWindow
+	}
+	return 0
+}
+
+func (m *Params) GetExemptAddresses() []string {
+	if m != nil {
+		return m.ExemptAddresses
+	}
+	return nil
+}
+
+func (m *Params) GetMaxTxGas() uint64 {
+	if m != nil {
+		return m.MaxTxGas
+	}
+	return 0
+}
+
+func (m *Params) GetMaxMsgsPerTx() uint64 {
+	if m != nil {
+		return m.MaxMsgsPerTx
+	}
+	return 0
+}
+
+func (m *Params) GetMaxNestedDepth() uint64 {
+	if m != nil {
+		return m.MaxNestedDepth
+	}
+	return 0
+}
+
+// AccountUsage is the number of txs paid by an account and their total gas
+// limit.
+type AccountUsage struct {
+	Txs uint64 `protobuf:"varint,1,opt,name=txs,proto3" json:"txs,omitempty"`
+	Gas uint64 `protobuf:"varint,2,opt,name=gas,proto3" json:"gas,omitempty"`
+}
+
+func (m *AccountUsage) Reset()         { *m = AccountUsage{} }
+func (m *AccountUsage) String() string { return proto.CompactTextString(m) }
+func (*AccountUsage) ProtoMessage()    {}
+func (*AccountUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2ef1f027b198b6d4, []int{1}
+}
+func (m *AccountUsage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccountUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AccountUsage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AccountUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountUsage.Merge(m, src)
+}
+func (m *AccountUsage) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccountUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountUsage proto.InternalMessageInfo
+
+func (m *AccountUsage) GetTxs() uint64 {
+	if m != nil {
+		return m.Txs
+	}
+	return 0
+}
+
+func (m *AccountUsage) GetGas() uint64 {
+	if m != nil {
+		return m.Gas
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "gaia.txlimit.v1beta1.Params")
+	proto.RegisterType((*AccountUsage)(nil), "gaia.txlimit.v1beta1.AccountUsage")
+}
+
+func init() {
+	proto.RegisterFile("gaia/txlimit/v1beta1/txlimit.proto", fileDescriptor_2ef1f027b198b6d4)
+}
+
+var fileDescriptor_2ef1f027b198b6d4 = []byte{
+	// 371 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x91, 0xc1, 0x4e, 0x2a, 0x31,
+	0x14, 0x86, 0x19, 0xe0, 0x72, 0xef, 0x6d, 0xb8, 0x17, 0xd2, 0xb0, 0x98, 0x7b, 0x63, 0x26, 0x04,
+	0xa3, 0x21, 0x31, 0x32, 0x41, 0x5f, 0x40, 0xd0, 0x84, 0x95, 0x86, 0x20, 0xc6, 0xc4, 0x4d, 0xd3,
+	0x99, 0x69, 0x86, 0x89, 0x74, 0x3a, 0x99, 0x53, 0xa4, 0xbe, 0x85, 0x0f, 0xe3, 0xce, 0x17, 0x70,
+	0x49, 0x5c, 0xb9, 0x34, 0xf0, 0x22, 0xa6, 0x2d, 0x43, 0x74, 0xd7, 0xf3, 0x9f, 0xef, 0xe4, 0xfc,
+	0x3d, 0x3f, 0xea, 0xc4, 0x34, 0xa1, 0xbe, 0x54, 0xf3, 0x84, 0x27, 0xd2, 0x7f, 0xe8, 0x07, 0x4c,
+	0xd2, 0x7e, 0x51, 0xf7, 0xb2, 0x5c, 0x48, 0x81, 0x5b, 0x9a, 0xe9, 0x15, 0xda, 0x96, 0xf9, 0xff,
+	0x2f, 0x14, 0xc0, 0x05, 0x10, 0xc3, 0xf8, 0xb6, 0xb0, 0x03, 0x9d, 0x97, 0x32, 0xaa, 0x8d, 0x69,
+	0x4e, 0x39, 0xe0, 0x7d, 0xf4, 0x67, 0x99, 0xa4, 0x91, 0x58, 0x92, 0x60, 0x2e, 0xc2, 0x7b, 0x70,
+	0x9d, 0xb6, 0xd3, 0xad, 0x4e, 0xea, 0x56, 0x1c, 0x1a, 0x0d, 0x1f, 0x21, 0xcc, 0xa9, 0x22, 0x52,
+	0x01, 0xc9, 0x58, 0x4e, 0x6c, 0xcf, 0x2d, 0x1b, 0xb2, 0xc1, 0xa9, 0x9a, 0x2a, 0x18, 0xb3, 0xfc,
+	0xd6, 0xc8, 0x05, 0x1c, 0xd3, 0x6f, 0x70, 0x65, 0x07, 0x8f, 0xe8, 0x17, 0xf8, 0x1c, 0x35, 0x99,
+	0x62, 0x3c, 0x93, 0x84, 0x46, 0x51, 0xce, 0x00, 0x18, 0xb8, 0xd5, 0x76, 0xa5, 0xfb, 0x7b, 0xe8,
+	0xbe, 0x3d, 0x1f, 0xb7, 0xb6, 0xae, 0x07, 0xb6, 0x77, 0x2d, 0xf3, 0x24, 0x8d, 0x27, 0x0d, 0x3b,
+	0x31, 0x28, 0x06, 0xf0, 0x1e, 0x42, 0xd6, 0x9e, 0x5e, 0xea, 0xfe, 0x30, 0x9b, 0x7e, 0x19, 0x5b,
+	0x23, 0x0a, 0xf8, 0x00, 0xe9, 0xad, 0x84, 0x43, 0x6c, 0x0d, 0x49, 0xe5, 0xd6, 0xec, 0x1f, 0x39,
+	0x55, 0x97, 0x10, 0x6b, 0x37, 0x53, 0x85, 0xbb, 0xa8, 0xa9, 0xb1, 0x94, 0x81, 0x64, 0x11, 0x89,
+	0x58, 0x26, 0x67, 0xee, 0x4f, 0xc3, 0xfd, 0xe5, 0x54, 0x5d, 0x19, 0xf9, 0x42, 0xab, 0x9d, 0x13,
+	0x54, 0x1f, 0x84, 0xa1, 0x58, 0xa4, 0xf2, 0x06, 0x68, 0xcc, 0x70, 0x13, 0x55, 0xa4, 0x2a, 0x0e,
+	0xa7, 0x9f, 0x5a, 0xd1, 0x4e, 0xec, 0x81, 0xf4, 0x73, 0x78, 0xf6, 0xba, 0xf6, 0x9c, 0xd5, 0xda,
+	0x73, 0x3e, 0xd6, 0x9e, 0xf3, 0xb4, 0xf1, 0x4a, 0xab, 0x8d, 0x57, 0x7a, 0xdf, 0x78, 0xa5, 0xbb,
+	0xc3, 0x38, 0x91, 0xb3, 0x45, 0xd0, 0x0b, 0x05, 0xdf, 0x86, 0xe4, 0x9b, 0xc8, 0xd5, 0x2e, 0x74,
+	0xf9, 0x98, 0x31, 0x08, 0x6a, 0x26, 0xba, 0xd3, 0xcf, 0x01, 0x00, 0x22, 0x9a, 0x58, 0xa1, 0x11,
+	0x02, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxNestedDepth != 0 {
+		i = encodeVarintTxlimit(dAtA, i, uint64(m.MaxNestedDepth))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.MaxMsgsPerTx != 0 {
+		i = encodeVarintTxlimit(dAtA, i, uint64(m.MaxMsgsPerTx))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.MaxTxGas != 0 {
+		i = encodeVarintTxlimit(dAtA, i, uint64(m.MaxTxGas))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.ExemptAddresses) > 0 {
+		for iNdEx := len(m.ExemptAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ExemptAddresses[iNdEx])
+			copy(dAtA[i:], m.ExemptAddresses[iNdEx])
+			i = encodeVarintTxlimit(dAtA, i, uint64(len(m.ExemptAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.MaxGasPerWindow != 0 {
+		i = encodeVarintTxlimit(dAtA, i, uint64(m.MaxGasPerWindow))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MaxTxsPerWindow != 0 {
+		i = encodeVarintTxlimit(dAtA, i, uint64(m.MaxTxsPerWindow))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.WindowBlocks != 0 {
+		i = encodeVarintTxlimit(dAtA, i, uint64(m.WindowBlocks))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AccountUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccountUsage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccountUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Gas != 0 {
+		i = encodeVarintTxlimit(dAtA, i, uint64(m.Gas))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Txs != 0 {
+		i = encodeVarintTxlimit(dAtA, i, uint64(m.Txs))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTxlimit(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTxlimit(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.WindowBlocks != 0 {
+		n += 1 + sovTxlimit(uint64(m.WindowBlocks))
+	}
+	if m.MaxTxsPerWindow != 0 {
+		n += 1 + sovTxlimit(uint64(m.MaxTxsPerWindow))
+	}
+	if m.MaxGasPerWindow != 0 {
+		n += 1 + sovTxlimit(uint64(m.MaxGasPerWindow))
+	}
+	if len(m.ExemptAddresses) > 0 {
+		for _, s := range m.ExemptAddresses {
+			l = len(s)
+			n += 1 + l + sovTxlimit(uint64(l))
+		}
+	}
+	if m.MaxTxGas != 0 {
+		n += 1 + sovTxlimit(uint64(m.MaxTxGas))
+	}
+	if m.MaxMsgsPerTx != 0 {
+		n += 1 + sovTxlimit(uint64(m.MaxMsgsPerTx))
+	}
+	if m.MaxNestedDepth != 0 {
+		n += 1 + sovTxlimit(uint64(m.MaxNestedDepth))
+	}
+	return n
+}
+
+func (m *AccountUsage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Txs != 0 {
+		n += 1 + sovTxlimit(uint64(m.Txs))
+	}
+	if m.Gas != 0 {
+		n += 1 + sovTxlimit(uint64(m.Gas))
+	}
+	return n
+}
+
+func sovTxlimit(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTxlimit(x uint64) (n int) {
+	return sovTxlimit(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTxlimit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowBlocks", wireType)
+			}
+			m.WindowBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxlimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTxsPerWindow", wireType)
+			}
+			m.MaxTxsPerWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxlimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxTxsPerWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxGasPerWindow", wireType)
+			}
+			m.MaxGasPerWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxlimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxGasPerWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExemptAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxlimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTxlimit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTxlimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExemptAddresses = append(m.ExemptAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTxGas", wireType)
+			}
+			m.MaxTxGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxlimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxTxGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMsgsPerTx", wireType)
+			}
+			m.MaxMsgsPerTx = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxlimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxMsgsPerTx |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxNestedDepth", wireType)
+			}
+			m.MaxNestedDepth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxlimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxNestedDepth |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTxlimit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTxlimit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AccountUsage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTxlimit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccountUsage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccountUsage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Txs", wireType)
+			}
+			m.Txs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxlimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Txs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gas", wireType)
+			}
+			m.Gas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxlimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Gas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTxlimit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTxlimit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTxlimit(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTxlimit
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTxlimit
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTxlimit
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTxlimit
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTxlimit
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTxlimit
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTxlimit        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTxlimit          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTxlimit = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,23 @@
+package types
+
+import "math"
+
+// Add returns the sum of the usages, capped at the max uint64.
+func (u AccountUsage) Add(other AccountUsage) AccountUsage {
+	return AccountUsage{
+		Txs: addCapped(u.Txs, other.Txs),
+		Gas: addCapped(u.Gas, other.Gas),
+	}
+}
+
+// IsZero returns true if the usage has no txs and no gas.
+func (u AccountUsage) IsZero() bool {
+	return u.Txs == 0 && u.Gas == 0
+}
+
+func addCapped(a, b uint64) uint64 {
+	if a > math.MaxUint64-b {
+		return math.MaxUint64
+	}
+	return a + b
+}